@@ -4,7 +4,10 @@ package env
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -31,6 +34,41 @@ var (
 type Config struct {
 	Mode   string
 	Prefix string
+
+	watchInterval   time.Duration
+	debounce        time.Duration
+	secretKeys      map[string]bool
+	fileSecrets     bool
+	normalizeKeys   bool
+	strictParse     bool
+	freeze          bool
+	snapshot        *envSnapshot
+	overrides       map[string]string
+	nilSlices       bool
+	numericBool     bool
+	upperKeys       bool
+	localeNumbers   bool
+	prefixEnvKey    string
+	filePriority    bool
+	decryptor       func(ciphertext string) (string, error)
+	aggregateErrors bool
+	searchPaths     []string
+	logger          Logger
+	warnUnexported  bool
+	extraFiles      []string
+	duplicateCheck  duplicateMode
+	skipUnsupported bool
+	observer        func(key string, value string, found bool)
+	profiles        []string
+	respectEmpty    bool
+	concatPrefix    bool
+
+	// watch berisi status Watch dan Close, dialokasikan saat pertama kali dibutuhkan
+	// sehingga Config tetap aman disalin
+	watch *watchState
+
+	// must menyimpan nilai yang sudah berhasil dibaca oleh Must, dilindungi oleh mustMu
+	must map[string]string
 }
 
 // getDefaultInstance yang thread-safe
@@ -90,15 +128,45 @@ func New(options ...ConfigOption) (*Config, error) {
 	if err := config.Load(); err != nil {
 		return nil, err
 	}
+	config.resolvePrefixFromEnv()
+	config.freezeIfNeeded()
 
 	return config, nil
 }
 
+// MustLoad sama seperti New, tetapi panic jika konfigurasi gagal dimuat. Pesan panic
+// menyebutkan mode dan file .env yang dicoba. Cocok untuk kode inisialisasi:
+// cfg := env.MustLoad(env.WithMode(env.Production))
+func MustLoad(options ...ConfigOption) *Config {
+	config, err := New(options...)
+	if err != nil {
+		probe := &Config{Mode: determineDefaultMode()}
+		for _, option := range options {
+			option(probe)
+		}
+		envFile, _ := probe.envFile()
+		panic(fmt.Sprintf("env: gagal memuat konfigurasi mode %q (file %q): %v", probe.Mode, envFile, err))
+	}
+	return config
+}
+
 // determineDefaultMode menentukan mode default berdasarkan ketersediaan file
 func determineDefaultMode() string {
+	mode, _ := DetectMode()
+	return mode
+}
+
+// DetectMode mengembalikan mode default yang akan dipilih beserta alasannya dalam bentuk
+// yang mudah dibaca, misalnya "hanya .env yang ada → production". Berguna untuk diagnosis
+// ketika aplikasi berjalan dengan mode yang tidak diharapkan
+func DetectMode() (mode string, reason string) {
 	// Cek jika mode diatur melalui APP_ENV
 	if envMode := os.Getenv("APP_ENV"); envMode != "" {
-		return envMode
+		mode = expandModeReferences(envMode)
+		if mode != envMode {
+			return mode, fmt.Sprintf("APP_ENV diset ke %q (hasil ekspansi %q)", mode, envMode)
+		}
+		return mode, fmt.Sprintf("APP_ENV diset ke %q", mode)
 	}
 
 	// Tentukan mode default berdasarkan file yang tersedia
@@ -108,99 +176,551 @@ func determineDefaultMode() string {
 
 	switch {
 	case hasEnv && hasStaging && hasDev:
-		return Development
+		return Development, ".env, .env.staging, dan .env.development ada → development"
 	case hasEnv && hasStaging:
-		return Staging
+		return Staging, ".env dan .env.staging ada → staging"
 	case hasEnv:
-		return Production
+		return Production, "hanya .env yang ada → production"
+	case hasStaging || hasDev:
+		return Development, ".env tidak ada, hanya .env.staging atau .env.development → development"
 	default:
-		return Development // Fallback ke development
+		return Development, "tidak ada file .env apa pun → development" // Fallback ke development
 	}
 }
 
+// maxModeExpansions membatasi ekspansi referensi bertingkat agar referensi melingkar berhenti
+const maxModeExpansions = 10
+
+// expandModeReferences mengekspansi referensi seperti ${DEPLOY_STAGE} pada nilai APP_ENV,
+// termasuk referensi bertingkat (variabel yang nilainya juga berupa referensi)
+func expandModeReferences(mode string) string {
+	for i := 0; i < maxModeExpansions && strings.Contains(mode, "$"); i++ {
+		mode = os.ExpandEnv(mode)
+	}
+	return mode
+}
+
 // fileExists memeriksa apakah file ada
 func fileExists(filename string) bool {
 	_, err := os.Stat(filename)
 	return err == nil
 }
 
-// Load membaca file .env sesuai dengan mode environment
-func (c *Config) Load() error {
-	var envFile string
-
+// envFile mengembalikan path file .env untuk mode environment saat ini. Dengan
+// WithSearchPaths, direktori pertama yang berisi file tersebut dipakai, dan jika tidak
+// ada yang berisi file, path di direktori pertama dikembalikan
+func (c *Config) envFile() (string, error) {
+	var name string
 	switch c.Mode {
 	case Production:
-		envFile = ".env"
+		name = ".env"
 	case Staging:
-		envFile = ".env.staging"
+		name = ".env.staging"
 	case Development:
-		envFile = ".env.development"
+		name = ".env.development"
 	default:
-		return fmt.Errorf("mode environment tidak valid: %s", c.Mode)
+		return "", fmt.Errorf("mode environment tidak valid: %s", c.Mode)
+	}
+
+	return c.locateFile(name), nil
+}
+
+// locateFile mengembalikan path file name. Dengan WithSearchPaths, direktori pertama yang
+// berisi file tersebut dipakai, dan jika tidak ada, path di direktori pertama dikembalikan
+func (c *Config) locateFile(name string) string {
+	if len(c.searchPaths) == 0 {
+		return name
+	}
+	for _, dir := range c.searchPaths {
+		if path := joinSearchPath(dir, name); fileExists(path) {
+			return path
+		}
+	}
+	return joinSearchPath(c.searchPaths[0], name)
+}
+
+// joinSearchPath menggabungkan direktori pencarian dengan nama file menggunakan separator
+// milik OS, sehingga path bergaya POSIX seperti config/env juga berlaku di Windows
+func joinSearchPath(dir, name string) string {
+	return filepath.Join(filepath.Clean(filepath.FromSlash(dir)), name)
+}
+
+// Load membaca file .env sesuai dengan mode environment. Baris bergaya skrip shell seperti
+// "export KEY=value" didukung: awalan "export " dibuang oleh parser godotenv, sedangkan
+// key yang memang bernama export (tanpa spasi setelahnya) tetap dibaca apa adanya
+func (c *Config) Load() error {
+	var files []string
+	var err error
+	if len(c.profiles) > 0 {
+		files, err = c.profileFiles()
+	} else {
+		files, err = c.modeFiles()
+	}
+	if err != nil {
+		return err
+	}
+
+	// File tambahan dari WithExtraFiles yang tidak ada dilewati
+	for _, file := range c.extraFiles {
+		if fileExists(file) {
+			files = append(files, file)
+		}
+	}
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	if err := c.checkDuplicates(files); err != nil {
+		return err
+	}
+
+	// Dengan WithProfiles, file berikutnya menimpa file sebelumnya
+	if len(c.profiles) > 0 {
+		return c.loadLayered(files)
+	}
+
+	// Load file .env, dengan WithFilePriority nilai dari file menimpa environment proses
+	if c.filePriority {
+		return godotenv.Overload(files...)
+	}
+	return godotenv.Load(files...)
+}
+
+// modeFiles mengembalikan file .env untuk mode saat ini jika ada. Jika tidak ada, mode
+// production menghasilkan error sedangkan mode lain hanya peringatan
+func (c *Config) modeFiles() ([]string, error) {
+	envFile, err := c.envFile()
+	if err != nil {
+		return nil, err
 	}
 
 	// Periksa apakah file ada
-	if !fileExists(envFile) {
-		// Jika file tidak ada dan mode bukan production, berikan peringatan tapi jangan error
-		if c.Mode != Production {
-			fmt.Printf("Peringatan: File %s tidak ditemukan\n", envFile)
-			return nil
+	if fileExists(envFile) {
+		return []string{envFile}, nil
+	}
+
+	// Jika file tidak ada dan mode bukan production, berikan peringatan tapi jangan error
+	if c.Mode == Production {
+		return nil, fmt.Errorf("file %s tidak ditemukan", envFile)
+	}
+	c.logf("Peringatan: File %s tidak ditemukan", envFile)
+	return nil, nil
+}
+
+// duplicateMode menentukan penanganan key yang didefinisikan di lebih dari satu file
+type duplicateMode int
+
+const (
+	duplicatesIgnore duplicateMode = iota
+	duplicatesWarn
+	duplicatesError
+)
+
+// checkDuplicates mencari key yang didefinisikan di lebih dari satu file, lalu menulis
+// peringatan atau mengembalikan error sesuai WithDetectDuplicates/WithErrorOnDuplicates
+func (c *Config) checkDuplicates(files []string) error {
+	if c.duplicateCheck == duplicatesIgnore || len(files) < 2 {
+		return nil
+	}
+
+	keyFiles := make(map[string][]string)
+	for _, file := range files {
+		values, err := godotenv.Read(file)
+		if err != nil {
+			return fmt.Errorf("gagal membaca file %s: %v", file, err)
+		}
+		for key := range values {
+			keyFiles[key] = append(keyFiles[key], file)
+		}
+	}
+
+	var duplicates []string
+	for key, definedIn := range keyFiles {
+		if len(definedIn) > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("%s (%s)", key, strings.Join(definedIn, ", ")))
 		}
-		return fmt.Errorf("file %s tidak ditemukan", envFile)
 	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	sort.Strings(duplicates)
+
+	if c.duplicateCheck == duplicatesError {
+		return fmt.Errorf("key didefinisikan di lebih dari satu file: %s", strings.Join(duplicates, "; "))
+	}
+	for _, duplicate := range duplicates {
+		c.logf("Peringatan: key %s didefinisikan di lebih dari satu file", duplicate)
+	}
+	return nil
+}
+
+// EncryptedPrefix adalah penanda nilai terenkripsi yang didekripsi oleh WithDecryptor
+const EncryptedPrefix = "enc:"
+
+// lookup mengambil nilai environment variable untuk key yang sudah diberi prefix.
+// Jika WithFileSecrets aktif dan nilainya kosong, nilai dibaca dari file yang
+// ditunjuk oleh <key>_FILE. Nilai berawalan EncryptedPrefix didekripsi jika
+// WithDecryptor aktif. Observer dari WithObserver dipanggil dengan hasilnya
+func (c *Config) lookup(prefixedKey string) (string, error) {
+	value, err := c.rawLookup(prefixedKey)
+	if err == nil {
+		value, err = c.decrypt(prefixedKey, value)
+	}
+
+	if c.observer != nil {
+		c.observe(prefixedKey, value)
+	}
+	return value, err
+}
+
+// observe memanggil observer untuk satu pembacaan. found bernilai true jika key diset
+// (meskipun kosong) atau nilainya berasal dari file <key>_FILE. Nilai key rahasia disamarkan
+func (c *Config) observe(prefixedKey, value string) {
+	_, found := c.getenv(prefixedKey)
+	if c.isSecret(prefixedKey) && value != "" {
+		value = RedactedValue
+	}
+	c.observer(prefixedKey, value, found || value != "")
+}
+
+// rawLookup membaca nilai dari environment atau dari file <key>_FILE tanpa dekripsi
+func (c *Config) rawLookup(prefixedKey string) (string, error) {
+	value, _ := c.getenv(prefixedKey)
+	if value != "" || !c.fileSecrets {
+		return value, nil
+	}
+
+	path, _ := c.getenv(prefixedKey + "_FILE")
+	if path == "" {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("gagal membaca file secret %s untuk %s: %v", path, prefixedKey, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// decrypt mendekripsi nilai berawalan EncryptedPrefix menggunakan decryptor.
+// Nilai tanpa penanda, atau tanpa decryptor, dikembalikan apa adanya
+func (c *Config) decrypt(prefixedKey, value string) (string, error) {
+	if c.decryptor == nil || !strings.HasPrefix(value, EncryptedPrefix) {
+		return value, nil
+	}
+
+	plaintext, err := c.decryptor(strings.TrimPrefix(value, EncryptedPrefix))
+	if err != nil {
+		return "", fmt.Errorf("gagal mendekripsi nilai %s: %v", prefixedKey, err)
+	}
+	return plaintext, nil
+}
 
-	// Load file .env
-	return godotenv.Load(envFile)
+// resolvePrefixFromEnv mengisi Prefix dari environment variable yang ditentukan oleh
+// WithPrefixFromEnv. Dipanggil sekali saat Config dibuat, setelah file .env dimuat
+func (c *Config) resolvePrefixFromEnv() {
+	if c.prefixEnvKey == "" {
+		return
+	}
+	c.Prefix = os.Getenv(c.prefixEnvKey)
+	c.prefixEnvKey = ""
 }
 
-// prependPrefix menambahkan prefix ke key jika ada
+// prependPrefix menambahkan prefix ke key jika ada. Dengan WithUpperKeys, key
+// diubah ke huruf besar sebelum prefix ditambahkan sehingga prefix tetap apa adanya
 func (c *Config) prependPrefix(key string) string {
-	if c.Prefix == "" {
-		return key
+	if c.upperKeys {
+		key = strings.ToUpper(key)
+	}
+	if c.Prefix != "" {
+		key = c.Prefix + key
+	}
+	if c.normalizeKeys {
+		key = normalizeKey(key)
+	}
+	return key
+}
+
+// keyNormalizer mengganti titik dan tanda hubung dengan underscore
+var keyNormalizer = strings.NewReplacer(".", "_", "-", "_")
+
+// normalizeKey mengubah key seperti app.db-host menjadi APP_DB_HOST
+func normalizeKey(key string) string {
+	return strings.ToUpper(keyNormalizer.Replace(key))
+}
+
+// clone membuat salinan Config
+func (c *Config) clone() *Config {
+	return &Config{
+		Mode:            c.Mode,
+		Prefix:          c.Prefix,
+		watchInterval:   c.watchInterval,
+		debounce:        c.debounce,
+		overrides:       c.overrides,
+		secretKeys:      c.secretKeys,
+		fileSecrets:     c.fileSecrets,
+		normalizeKeys:   c.normalizeKeys,
+		strictParse:     c.strictParse,
+		freeze:          c.freeze,
+		snapshot:        c.snapshot,
+		nilSlices:       c.nilSlices,
+		numericBool:     c.numericBool,
+		upperKeys:       c.upperKeys,
+		localeNumbers:   c.localeNumbers,
+		filePriority:    c.filePriority,
+		decryptor:       c.decryptor,
+		aggregateErrors: c.aggregateErrors,
+		searchPaths:     c.searchPaths,
+		logger:          c.logger,
+		warnUnexported:  c.warnUnexported,
+		extraFiles:      c.extraFiles,
+		duplicateCheck:  c.duplicateCheck,
+		skipUnsupported: c.skipUnsupported,
+		observer:        c.observer,
+		profiles:        c.profiles,
+		respectEmpty:    c.respectEmpty,
+		concatPrefix:    c.concatPrefix,
 	}
-	return c.Prefix + key
 }
 
 // From membuat instance baru dengan opsi untuk mendukung chaining
 func (c *Config) From(options ...ConfigOption) *Config {
-	newConfig := &Config{
-		Mode:   c.Mode,
-		Prefix: c.Prefix,
-	}
+	newConfig := c.clone()
 
 	for _, option := range options {
 		option(newConfig)
 	}
+	newConfig.resolvePrefixFromEnv()
+	newConfig.freezeIfNeeded()
 
 	return newConfig
 }
 
+// Merge menggabungkan other ke salinan c dan mengembalikan hasilnya tanpa mengubah keduanya.
+// Jika terjadi konflik, nilai other yang menang: Mode, Prefix, dan interval Watch dari
+// other dipakai jika tidak kosong, key rahasia digabungkan, dan opsi boolean seperti
+// WithFileSecrets aktif jika aktif di salah satunya. Override dari WithContext digabungkan
+// dengan override other yang diutamakan. File .env tidak dimuat ulang
+func (c *Config) Merge(other *Config) *Config {
+	merged := c.clone()
+	if other == nil {
+		return merged
+	}
+
+	if other.Mode != "" {
+		merged.Mode = other.Mode
+	}
+	if other.Prefix != "" {
+		merged.Prefix = other.Prefix
+	}
+	if len(other.searchPaths) > 0 {
+		merged.searchPaths = other.searchPaths
+	}
+	if other.watchInterval > 0 {
+		merged.watchInterval = other.watchInterval
+	}
+	if other.debounce > 0 {
+		merged.debounce = other.debounce
+	}
+	if len(other.secretKeys) > 0 {
+		secretKeys := make([]string, 0, len(other.secretKeys))
+		for k := range other.secretKeys {
+			secretKeys = append(secretKeys, k)
+		}
+		WithSecrets(secretKeys...)(merged)
+	}
+	merged.fileSecrets = merged.fileSecrets || other.fileSecrets
+	merged.normalizeKeys = merged.normalizeKeys || other.normalizeKeys
+	merged.strictParse = merged.strictParse || other.strictParse
+	merged.nilSlices = merged.nilSlices || other.nilSlices
+	merged.numericBool = merged.numericBool || other.numericBool
+	merged.upperKeys = merged.upperKeys || other.upperKeys
+	merged.localeNumbers = merged.localeNumbers || other.localeNumbers
+	merged.filePriority = merged.filePriority || other.filePriority
+	merged.aggregateErrors = merged.aggregateErrors || other.aggregateErrors
+	merged.warnUnexported = merged.warnUnexported || other.warnUnexported
+	merged.skipUnsupported = merged.skipUnsupported || other.skipUnsupported
+	merged.respectEmpty = merged.respectEmpty || other.respectEmpty
+	merged.concatPrefix = merged.concatPrefix || other.concatPrefix
+	if other.logger != nil {
+		merged.logger = other.logger
+	}
+	if other.observer != nil {
+		merged.observer = other.observer
+	}
+	if len(other.overrides) > 0 {
+		merged.overrides = mergeOverrides(merged.overrides, other.overrides)
+	}
+	if len(other.extraFiles) > 0 {
+		merged.extraFiles = other.extraFiles
+	}
+	if len(other.profiles) > 0 {
+		merged.profiles = other.profiles
+	}
+	if other.duplicateCheck > merged.duplicateCheck {
+		merged.duplicateCheck = other.duplicateCheck
+	}
+	if other.decryptor != nil {
+		merged.decryptor = other.decryptor
+	}
+	if other.freeze {
+		merged.freeze = true
+		merged.freezeIfNeeded()
+	}
+
+	return merged
+}
+
 // Key menghasilkan result untuk key tertentu untuk mendukung chaining
 func (c *Config) Key(key string) *result {
 	prefixedKey := c.prependPrefix(key)
-	value := os.Getenv(prefixedKey)
+	value, err := c.lookup(prefixedKey)
 	return &result{
 		config: c,
 		key:    prefixedKey,
 		value:  value,
-		err:    nil,
+		err:    err,
+	}
+}
+
+// KeyWithFallbacks sama seperti Key, tetapi jika nilai primary kosong, setiap key
+// fallback (dengan prefix) dicoba secara berurutan. Cocok untuk rantai rename/deprecation
+func (c *Config) KeyWithFallbacks(primary string, fallbacks ...string) *result {
+	r := c.Key(primary)
+	for _, fallback := range fallbacks {
+		r = r.OrKey(fallback)
 	}
+	return r
+}
+
+// KeyWithDefaults sama seperti Key, tetapi jika nilai kosong, default diambil dari
+// defaults[key] (key tanpa prefix), misalnya map hasil membaca file defaults. Urutannya:
+// nilai environment, lalu defaults, lalu Default pada chain yang hanya berlaku jika key
+// tidak ada di defaults. Tag default hanya dipakai oleh Parse dan tidak terlibat di sini
+func (c *Config) KeyWithDefaults(key string, defaults map[string]string) *result {
+	return c.Key(key).Default(defaults[key])
 }
 
-// Get mengambil nilai environment variable sebagai string
+// Keyf sama seperti Key, tetapi key dibentuk dengan fmt.Sprintf
+func (c *Config) Keyf(format string, args ...interface{}) *result {
+	return c.Key(fmt.Sprintf(format, args...))
+}
+
+// Getf sama seperti Get tanpa nilai default, tetapi key dibentuk dengan fmt.Sprintf
+func (c *Config) Getf(format string, args ...interface{}) string {
+	return c.Get(fmt.Sprintf(format, args...))
+}
+
+// Get mengambil nilai environment variable sebagai string. Jika pembacaan gagal (misalnya
+// dekripsi atau file secret gagal), error dicatat melalui logger dan hasilnya string kosong;
+// nilai default tidak dipakai agar konfigurasi yang rusak tidak tertutupi nilai bawaan
 func (c *Config) Get(key string, defaultValue ...string) string {
-	prefixedKey := c.prependPrefix(key)
-	value := os.Getenv(prefixedKey)
+	value, ok := c.lookupLogged(c.prependPrefix(key))
+	if !ok {
+		return ""
+	}
 	if value == "" && len(defaultValue) > 0 {
 		return defaultValue[0]
 	}
 	return value
 }
 
+// lookupLogged seperti lookup untuk getter yang tidak mengembalikan error. Error pembacaan
+// dicatat melalui logger dan ok bernilai false, sehingga pemanggil mengembalikan nilai nol
+// tanpa memakai default
+func (c *Config) lookupLogged(prefixedKey string) (string, bool) {
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		c.logf("Peringatan: %v", err)
+		return "", false
+	}
+	return value, true
+}
+
+// GetValidated mengambil nilai environment variable (dengan default jika kosong), lalu
+// menjalankan validate terhadap nilai tersebut. Error dari validate dikembalikan apa adanya
+// sehingga errors.Is tetap berfungsi, dan nilainya menjadi string kosong
+func (c *Config) GetValidated(key string, validate func(string) error, defaultValue ...string) (string, error) {
+	prefixedKey := c.prependPrefix(key)
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		return "", err
+	}
+	if value == "" && len(defaultValue) > 0 {
+		value = defaultValue[0]
+	}
+
+	if validate != nil {
+		if err := validate(value); err != nil {
+			return "", err
+		}
+	}
+	return value, nil
+}
+
+// GetWithFallbacks mengambil nilai primary, lalu setiap key fallback secara berurutan,
+// dan mengembalikan nilai pertama yang tidak kosong. Semua key diberi prefix. Jika tidak
+// ada yang diisi, hasilnya string kosong (bukan error) sehingga default diatur pemanggil
+func (c *Config) GetWithFallbacks(primary string, fallbacks ...string) string {
+	return c.KeyWithFallbacks(primary, fallbacks...).String()
+}
+
+// mustMu melindungi cache Must pada semua Config
+var mustMu sync.Mutex
+
+// Must mengambil nilai environment variable sebagai string dan panic jika kosong atau
+// gagal dibaca. Pesan panic menyebutkan key (dengan prefix) dan mode saat ini.
+// Nilai yang berhasil dibaca disimpan per key sehingga pemanggilan berikutnya tidak
+// membaca ulang environment. Cache dikosongkan oleh Reload dan reload dari Watch
+func (c *Config) Must(key string) string {
+	mustMu.Lock()
+	defer mustMu.Unlock()
+
+	if value, ok := c.must[key]; ok {
+		return value
+	}
+	r := c.Key(key).Required()
+	if r.err != nil {
+		panic(fmt.Sprintf("env: %v (mode %q)", r.err, c.Mode))
+	}
+	if c.must == nil {
+		c.must = make(map[string]string)
+	}
+	c.must[key] = r.value
+	return r.value
+}
+
+// resetMust mengosongkan cache Must setelah nilai dimuat ulang
+func (c *Config) resetMust() {
+	mustMu.Lock()
+	defer mustMu.Unlock()
+
+	c.must = nil
+}
+
+// GetOrFunc mengambil nilai environment variable sebagai string, atau hasil fn jika kosong.
+// fn hanya dipanggil jika dibutuhkan, cocok untuk default yang mahal dihitung
+func (c *Config) GetOrFunc(key string, fn func() string) string {
+	if value := c.Get(key); value != "" {
+		return value
+	}
+	return fn()
+}
+
+// Exists memeriksa apakah environment variable diset, termasuk jika nilainya kosong
+func (c *Config) Exists(key string) bool {
+	_, ok := c.getenv(c.prependPrefix(key))
+	return ok
+}
+
 // GetInt mengambil nilai environment variable sebagai integer
 func (c *Config) GetInt(key string, defaultValue ...int) (int, error) {
 	prefixedKey := c.prependPrefix(key)
-	value := os.Getenv(prefixedKey)
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		return 0, err
+	}
 	if value == "" {
 		if len(defaultValue) > 0 {
 			return defaultValue[0], nil
@@ -208,13 +728,16 @@ func (c *Config) GetInt(key string, defaultValue ...int) (int, error) {
 		return 0, fmt.Errorf("environment variable %s tidak ditemukan", prefixedKey)
 	}
 
-	return strconv.Atoi(value)
+	return strconv.Atoi(c.numberText(value))
 }
 
 // GetInt64 mengambil nilai environment variable sebagai int64
 func (c *Config) GetInt64(key string, defaultValue ...int64) (int64, error) {
 	prefixedKey := c.prependPrefix(key)
-	value := os.Getenv(prefixedKey)
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		return 0, err
+	}
 	if value == "" {
 		if len(defaultValue) > 0 {
 			return defaultValue[0], nil
@@ -222,13 +745,38 @@ func (c *Config) GetInt64(key string, defaultValue ...int64) (int64, error) {
 		return 0, fmt.Errorf("environment variable %s tidak ditemukan", prefixedKey)
 	}
 
-	return strconv.ParseInt(value, 10, 64)
+	return strconv.ParseInt(c.numberText(value), 10, 64)
+}
+
+// GetBytes mengambil nilai environment variable berupa ukuran seperti "512MB" atau "1GiB"
+// sebagai jumlah byte, lihat result.Bytes untuk satuan yang didukung
+func (c *Config) GetBytes(key string, defaultValue ...int64) (int64, error) {
+	r := c.Key(key)
+	if r.err == nil && r.value == "" && len(defaultValue) > 0 {
+		return defaultValue[0], nil
+	}
+	return r.Bytes()
+}
+
+// GetDurationSum mengambil jumlah dari beberapa durasi yang dipisahkan delimiter,
+// misalnya TOTAL_WINDOW=1h,30m,15s, lihat result.DurationSum
+func (c *Config) GetDurationSum(key string, delimiter string) (time.Duration, error) {
+	return c.Key(key).DurationSum(delimiter)
+}
+
+// GetUintN mengambil nilai environment variable sebagai bilangan bulat tak bertanda
+// dengan lebar bitSize dan basis base, lihat result.UintN
+func (c *Config) GetUintN(key string, bitSize int, base int) (uint64, error) {
+	return c.Key(key).UintN(bitSize, base)
 }
 
 // GetFloat64 mengambil nilai environment variable sebagai float64
 func (c *Config) GetFloat64(key string, defaultValue ...float64) (float64, error) {
 	prefixedKey := c.prependPrefix(key)
-	value := os.Getenv(prefixedKey)
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		return 0, err
+	}
 	if value == "" {
 		if len(defaultValue) > 0 {
 			return defaultValue[0], nil
@@ -236,13 +784,91 @@ func (c *Config) GetFloat64(key string, defaultValue ...float64) (float64, error
 		return 0, fmt.Errorf("environment variable %s tidak ditemukan", prefixedKey)
 	}
 
-	return strconv.ParseFloat(value, 64)
+	return strconv.ParseFloat(c.numberText(value), 64)
 }
 
-// GetBool mengambil nilai environment variable sebagai boolean
-func (c *Config) GetBool(key string, defaultValue ...bool) bool {
+// numberText menyiapkan nilai bilangan tunggal sebelum diparse: spasi di awal dan akhir
+// selalu dibuang (" 42 " menjadi "42"), dan koma pemisah ribuan ("1,000" menjadi "1000")
+// dibuang jika WithLocaleNumbers aktif. Pemisah desimal selalu titik. Koma hanya dibuang
+// jika berada di posisi pemisah ribuan yang valid, selain itu nilai dibiarkan apa adanya
+// sehingga parsing gagal (misalnya "1,5" atau "12,34,5")
+func (c *Config) numberText(value string) string {
+	value = strings.TrimSpace(value)
+	if c != nil && c.localeNumbers && validGrouping(value) {
+		return strings.ReplaceAll(value, ",", "")
+	}
+	return value
+}
+
+// validGrouping memeriksa apakah koma pada value hanya memisahkan ribuan di bagian bulat:
+// kelompok pertama berisi 1-3 digit dan setiap kelompok berikutnya tepat 3 digit
+func validGrouping(value string) bool {
+	integer := strings.TrimLeft(value, "+-")
+	if i := strings.IndexAny(integer, ".eE"); i >= 0 {
+		if strings.Contains(integer[i:], ",") {
+			return false
+		}
+		integer = integer[:i]
+	}
+
+	groups := strings.Split(integer, ",")
+	for i, group := range groups {
+		if i == 0 && len(groups) > 1 && (len(group) < 1 || len(group) > 3) {
+			return false
+		}
+		if i > 0 && len(group) != 3 {
+			return false
+		}
+	}
+	return true
+}
+
+// GetFloat64Or mengambil nilai environment variable sebagai float64, atau def jika tidak
+// ada atau tidak valid. Berbeda dengan GetFloat64 yang mengembalikan error untuk nilai tidak valid
+func (c *Config) GetFloat64Or(key string, def float64) float64 {
+	value, err := c.GetFloat64(key)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// GetFloat64Clamped mengambil nilai environment variable sebagai float64 yang dibatasi
+// dalam rentang [minValue, maxValue]. Nilai yang tidak ada, tidak valid, NaN, atau Inf
+// menghasilkan defaultValue
+func (c *Config) GetFloat64Clamped(key string, minValue, maxValue, defaultValue float64) float64 {
+	value, err := c.GetFloat64(key)
+	if err != nil {
+		return defaultValue
+	}
+	return clampFloat64(value, minValue, maxValue, defaultValue)
+}
+
+// GetPercent mengambil nilai persentase sebagai pecahan, misalnya "25%" menjadi 0.25.
+// Nilai tanpa tanda % dibaca apa adanya sebagai float
+func (c *Config) GetPercent(key string, defaultValue ...float64) (float64, error) {
 	prefixedKey := c.prependPrefix(key)
-	value := os.Getenv(prefixedKey)
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		return 0, err
+	}
+	if value == "" {
+		if len(defaultValue) > 0 {
+			return defaultValue[0], nil
+		}
+		return 0, fmt.Errorf("environment variable %s tidak ditemukan", prefixedKey)
+	}
+
+	return parsePercent(value)
+}
+
+// GetBool mengambil nilai environment variable sebagai boolean. Seperti Get, pembacaan
+// yang gagal dicatat melalui logger dan menghasilkan false tanpa memakai default
+func (c *Config) GetBool(key string, defaultValue ...bool) bool {
+	value, ok := c.lookupLogged(c.prependPrefix(key))
+	if !ok {
+		return false
+	}
 	if value == "" {
 		if len(defaultValue) > 0 {
 			return defaultValue[0]
@@ -250,14 +876,58 @@ func (c *Config) GetBool(key string, defaultValue ...bool) bool {
 		return false
 	}
 
+	return c.parseBool(value)
+}
+
+// GetBoolPtr mengambil nilai environment variable sebagai *bool untuk flag tiga keadaan:
+// nil jika tidak diset atau kosong (misalnya "ikuti default"), dan pointer ke nilai boolean
+// jika diisi. Nilai yang tidak dikenali sebagai boolean juga menghasilkan nil, bukan false
+func (c *Config) GetBoolPtr(key string) *bool {
+	value, _ := c.lookupLogged(c.prependPrefix(key))
+	if value == "" {
+		return nil
+	}
+
+	b, ok := c.lookupBool(value)
+	if !ok {
+		return nil
+	}
+	return &b
+}
+
+// parseBool mengubah nilai menjadi boolean. "true", "1", "yes", dan "y" bernilai true.
+// Jika WithNumericBool aktif, semua bilangan bulat selain 0 juga bernilai true
+func (c *Config) parseBool(value string) bool {
+	b, _ := c.lookupBool(value)
+	return b
+}
+
+// lookupBool seperti parseBool, tetapi juga melaporkan apakah nilai dikenali.
+// "false", "0", "no", dan "n" dikenali sebagai false
+func (c *Config) lookupBool(value string) (bool, bool) {
 	value = strings.ToLower(value)
-	return value == "true" || value == "1" || value == "yes" || value == "y"
+	switch value {
+	case "true", "1", "yes", "y":
+		return true, true
+	case "false", "0", "no", "n":
+		return false, true
+	}
+
+	if c != nil && c.numericBool {
+		if number, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil {
+			return number != 0, true
+		}
+	}
+	return false, false
 }
 
 // GetDuration mengambil nilai environment variable sebagai time.Duration
 func (c *Config) GetDuration(key string, defaultValue ...time.Duration) (time.Duration, error) {
 	prefixedKey := c.prependPrefix(key)
-	value := os.Getenv(prefixedKey)
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		return 0, err
+	}
 	if value == "" {
 		if len(defaultValue) > 0 {
 			return defaultValue[0], nil
@@ -268,20 +938,59 @@ func (c *Config) GetDuration(key string, defaultValue ...time.Duration) (time.Du
 	return time.ParseDuration(value)
 }
 
+// GetTime mengambil nilai environment variable sebagai time.Time dengan mencoba setiap
+// layout secara berurutan, atau DefaultTimeLayouts jika tidak ada layout yang diberikan
+func (c *Config) GetTime(key string, layouts ...string) (time.Time, error) {
+	return c.Key(key).Time(layouts...)
+}
+
+// GetDurationOr mengambil nilai environment variable sebagai time.Duration, atau def jika
+// tidak ada atau tidak valid. Berbeda dengan GetDuration yang mengembalikan error untuk
+// nilai tidak valid seperti "5 menit"
+func (c *Config) GetDurationOr(key string, def time.Duration) time.Duration {
+	value, err := c.GetDuration(key)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// GetDurationBounded mengambil nilai environment variable sebagai time.Duration yang
+// dibatasi dalam rentang [minValue, maxValue]. Nilai di luar rentang dipotong ke batas
+// terdekat (bukan error), sedangkan nilai yang tidak ada atau tidak valid menghasilkan defaultValue
+func (c *Config) GetDurationBounded(key string, minValue, maxValue, defaultValue time.Duration) time.Duration {
+	value, err := c.GetDuration(key)
+	if err != nil {
+		return defaultValue
+	}
+	return clampDuration(value, minValue, maxValue)
+}
+
+// emptySlice mengembalikan slice kosong, atau nil jika WithNilEmptySlices aktif
+func (c *Config) emptySlice() []string {
+	if c != nil && c.nilSlices {
+		return nil
+	}
+	return []string{}
+}
+
 // GetSlice mengambil nilai environment variable sebagai slice string
-// Nilai dalam file .env harus dipisahkan dengan delimiter (defaultnya ",")
+// Nilai dalam file .env harus dipisahkan dengan delimiter (defaultnya ",").
+// Pembacaan yang gagal dicatat melalui logger dan menghasilkan slice kosong tanpa default
 func (c *Config) GetSlice(key string, delimiter string, defaultValue ...[]string) []string {
 	if delimiter == "" {
 		delimiter = ","
 	}
 
-	prefixedKey := c.prependPrefix(key)
-	value := os.Getenv(prefixedKey)
+	value, ok := c.lookupLogged(c.prependPrefix(key))
+	if !ok {
+		return c.emptySlice()
+	}
 	if value == "" {
 		if len(defaultValue) > 0 {
 			return defaultValue[0]
 		}
-		return []string{}
+		return c.emptySlice()
 	}
 
 	parts := strings.Split(value, delimiter)
@@ -293,11 +1002,76 @@ func (c *Config) GetSlice(key string, delimiter string, defaultValue ...[]string
 	return parts
 }
 
+// GetSliceMap mengambil nilai environment variable sebagai map ke list, misalnya
+// ROUTES=web:a.com|b.com,api:c.com. Lihat result.SliceMap untuk ketiga pemisah dan trim
+func (c *Config) GetSliceMap(key, pairDelim, kvDelim, listDelim string) map[string][]string {
+	return c.Key(key).SliceMap(pairDelim, kvDelim, listDelim)
+}
+
+// GetURLParams mengambil parameter query dari environment variable berupa URL, misalnya
+// untuk REDIS_URL=redis://host:6379?db=2&pool=10. Lihat result.URLParam untuk parameter bertipe
+func (c *Config) GetURLParams(key string) (url.Values, error) {
+	return c.Key(key).URLParams()
+}
+
+// GetSliceIndexedMap mengambil nilai slice yang dipisahkan koma sebagai map indeks ke elemen,
+// lihat result.SliceIndexedMap untuk penanganan elemen kosong
+func (c *Config) GetSliceIndexedMap(key string) map[int]string {
+	return c.Key(key).SliceIndexedMap(",")
+}
+
+// GetSliceRaw mengambil nilai environment variable sebagai slice string tanpa trim
+// spasi dan tanpa membuang elemen kosong
+func (c *Config) GetSliceRaw(key string, delimiter string, defaultValue ...[]string) []string {
+	if delimiter == "" {
+		delimiter = ","
+	}
+
+	value, ok := c.lookupLogged(c.prependPrefix(key))
+	if !ok {
+		return c.emptySlice()
+	}
+	if value == "" {
+		if len(defaultValue) > 0 {
+			return defaultValue[0]
+		}
+		return c.emptySlice()
+	}
+
+	return strings.Split(value, delimiter)
+}
+
+// GetGlob membaca pola glob seperti /etc/app/*.conf lalu mengembalikan path file yang cocok
+// menggunakan filepath.Glob. Nilai kosong atau tanpa kecocokan menghasilkan slice kosong,
+// sedangkan pola yang tidak valid menghasilkan error
+func (c *Config) GetGlob(key string) ([]string, error) {
+	prefixedKey := c.prependPrefix(key)
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return c.emptySlice(), nil
+	}
+
+	matches, err := expandGlob(value)
+	if err != nil {
+		return nil, fmt.Errorf("pola glob tidak valid untuk %s: %v", prefixedKey, err)
+	}
+	if len(matches) == 0 {
+		return c.emptySlice(), nil
+	}
+	return matches, nil
+}
+
 // GetMap mengambil nilai environment variable sebagai map[string]string
-// Format dalam file .env harus key1:value1,key2:value2
+// Format dalam file .env harus key1:value1,key2:value2. Pembacaan yang gagal dicatat
+// melalui logger dan menghasilkan map kosong tanpa default
 func (c *Config) GetMap(key string, defaultValue ...map[string]string) map[string]string {
-	prefixedKey := c.prependPrefix(key)
-	value := os.Getenv(prefixedKey)
+	value, ok := c.lookupLogged(c.prependPrefix(key))
+	if !ok {
+		return map[string]string{}
+	}
 	if value == "" {
 		if len(defaultValue) > 0 {
 			return defaultValue[0]
@@ -320,6 +1094,138 @@ func (c *Config) GetMap(key string, defaultValue ...map[string]string) map[strin
 	return result
 }
 
+// GetInferredMap mengambil nilai environment variable berformat key1:value1,key2:value2
+// sebagai map[string]interface{} dengan tipe setiap nilai ditebak, lihat inferValue
+func (c *Config) GetInferredMap(key string) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, pair := range c.GetPairs(key, ",", ":") {
+		result[pair.Key] = inferValue(pair.Value)
+	}
+	return result
+}
+
+// inferValue menebak tipe nilai dengan urutan: bool ("true"/"false", tanpa membedakan huruf
+// besar kecil), int, float64, lalu string. Hanya nilai yang jelas numerik yang menjadi angka:
+// angka dengan nol di depan seperti "007" tetap string, begitu juga "inf" atau "nan"
+func inferValue(value string) interface{} {
+	switch strings.ToLower(value) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	if !isClearlyNumeric(value) {
+		return value
+	}
+	if i, err := strconv.Atoi(value); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	return value
+}
+
+// isClearlyNumeric memeriksa apakah nilai berupa angka desimal biasa tanpa nol di depan
+func isClearlyNumeric(value string) bool {
+	digits := strings.TrimPrefix(strings.TrimPrefix(value, "-"), "+")
+	if digits == "" || digits[0] < '0' || digits[0] > '9' {
+		return false
+	}
+	if len(digits) > 1 && digits[0] == '0' && digits[1] != '.' {
+		return false
+	}
+	for _, r := range digits {
+		if (r < '0' || r > '9') && r != '.' && r != 'e' && r != 'E' && r != '-' && r != '+' {
+			return false
+		}
+	}
+	return true
+}
+
+// GetMapFromEnv mengumpulkan semua environment variable berawalan Prefix+subPrefix ke dalam
+// map dengan key berupa sisa nama variabel, misalnya APP_LABEL_TEAM=core menjadi
+// {"TEAM": "core"} untuk subPrefix LABEL_. Berbeda dengan GetMap, nilai bebas berisi koma
+// maupun titik dua. Jika lowercase bernilai true, key map diubah ke huruf kecil
+func (c *Config) GetMapFromEnv(subPrefix string, lowercase ...bool) map[string]string {
+	fullPrefix := c.prependPrefix(subPrefix)
+	lower := len(lowercase) > 0 && lowercase[0]
+
+	names := make(map[string]bool)
+	for _, kv := range c.environ() {
+		if name := strings.SplitN(kv, "=", 2)[0]; strings.HasPrefix(name, fullPrefix) {
+			names[name] = true
+		}
+	}
+	for name := range c.overrides {
+		if strings.HasPrefix(name, fullPrefix) {
+			names[name] = true
+		}
+	}
+
+	result := make(map[string]string)
+	for name := range names {
+		key := strings.TrimPrefix(name, fullPrefix)
+		if key == "" {
+			continue
+		}
+		if lower {
+			key = strings.ToLower(key)
+		}
+		result[key], _ = c.lookupLogged(name)
+	}
+	return result
+}
+
+// GetPairs mengambil nilai environment variable sebagai daftar pasangan key/value.
+// Berbeda dengan GetMap, urutan dipertahankan dan key boleh duplikat
+func (c *Config) GetPairs(key, pairDelim, kvDelim string) []KeyValue {
+	value, _ := c.lookupLogged(c.prependPrefix(key))
+	return parsePairs(value, pairDelim, kvDelim)
+}
+
+// expandGlob mengembalikan path yang cocok dengan pattern, selalu berupa slice non-nil
+func expandGlob(pattern string) ([]string, error) {
+	matches, err := filepath.Glob(strings.TrimSpace(pattern))
+	if err != nil {
+		return nil, err
+	}
+	if matches == nil {
+		matches = []string{}
+	}
+	return matches, nil
+}
+
+// ConfigInfo berisi ringkasan pengaturan Config yang sedang berlaku
+type ConfigInfo struct {
+	Mode    string
+	Prefix  string
+	EnvFile string
+	// FilePriority bernilai true jika nilai dari file .env menimpa environment proses
+	// (WithFilePriority), dan false jika environment proses yang diutamakan
+	FilePriority bool
+	// Profiles berisi profile dari WithProfiles sesuai urutan penerapan
+	Profiles []string
+	// EnvFiles berisi file yang ada dan dimuat sesuai urutan, termasuk file profile
+	// dan file dari WithExtraFiles
+	EnvFiles []string
+}
+
+// Info mengembalikan ringkasan pengaturan Config, termasuk urutan prioritas antara
+// file .env dan environment proses
+func (c *Config) Info() ConfigInfo {
+	envFile, _ := c.envFile()
+	return ConfigInfo{
+		Mode:         c.Mode,
+		Prefix:       c.Prefix,
+		EnvFile:      envFile,
+		FilePriority: c.filePriority,
+		Profiles:     c.profiles,
+		EnvFiles:     c.loadedFiles(),
+	}
+}
+
 // GetMode mengembalikan mode environment saat ini
 func (c *Config) GetMode() string {
 	return c.Mode
@@ -368,6 +1274,35 @@ func Get(key string, defaultValue ...string) string {
 	return cfg.Get(key, defaultValue...)
 }
 
+// GetValidated adalah fungsi level package yang mengambil nilai string dari environment
+// lalu memvalidasinya dengan validate
+func GetValidated(key string, validate func(string) error, defaultValue ...string) (string, error) {
+	cfg, err := getDefaultInstance()
+	if err != nil {
+		return "", err
+	}
+	return cfg.GetValidated(key, validate, defaultValue...)
+}
+
+// GetOrFunc adalah fungsi level package yang mengambil nilai string dari environment,
+// atau hasil fn jika kosong
+func GetOrFunc(key string, fn func() string) string {
+	cfg, err := getDefaultInstance()
+	if err != nil {
+		return fn()
+	}
+	return cfg.GetOrFunc(key, fn)
+}
+
+// Exists adalah fungsi level package yang memeriksa apakah environment variable diset
+func Exists(key string) bool {
+	cfg, err := getDefaultInstance()
+	if err != nil {
+		return false
+	}
+	return cfg.Exists(key)
+}
+
 // GetInt adalah fungsi level package yang mengambil nilai int dari environment
 func GetInt(key string, defaultValue ...int) (int, error) {
 	cfg, err := getDefaultInstance()
@@ -428,6 +1363,40 @@ func GetDuration(key string, defaultValue ...time.Duration) (time.Duration, erro
 	return cfg.GetDuration(key, defaultValue...)
 }
 
+// Value adalah fungsi level package generik yang mengambil nilai bertipe T dari environment,
+// misalnya env.Value[int]("PORT", 8080). Tipe yang didukung: string, int, bool, float64,
+// dan time.Duration. Tipe lain, termasuk named type seperti `type myInt int`, menghasilkan error.
+// Jika nilai gagal di-parse, default dikembalikan bersama error-nya
+func Value[T any](key string, defaultValue ...T) (T, error) {
+	var value T
+	cfg, err := getDefaultInstance()
+	if err != nil {
+		if len(defaultValue) > 0 {
+			return defaultValue[0], err
+		}
+		return value, err
+	}
+
+	switch p := any(&value).(type) {
+	case *string:
+		*p = cfg.Get(key, any(defaultValue).([]string)...)
+	case *int:
+		*p, err = cfg.GetInt(key, any(defaultValue).([]int)...)
+	case *bool:
+		*p = cfg.GetBool(key, any(defaultValue).([]bool)...)
+	case *float64:
+		*p, err = cfg.GetFloat64(key, any(defaultValue).([]float64)...)
+	case *time.Duration:
+		*p, err = cfg.GetDuration(key, any(defaultValue).([]time.Duration)...)
+	default:
+		return value, fmt.Errorf("tipe %T tidak didukung oleh Value untuk key %s", value, key)
+	}
+	if err != nil && len(defaultValue) > 0 {
+		return defaultValue[0], err
+	}
+	return value, err
+}
+
 // GetSlice adalah fungsi level package yang mengambil nilai []string dari environment
 func GetSlice(key string, delimiter string, defaultValue ...[]string) []string {
 	cfg, err := getDefaultInstance()
@@ -492,11 +1461,21 @@ func IsDevelopment() bool {
 func Key(key string) *result {
 	cfg, err := getDefaultInstance()
 	if err != nil {
-		return &result{err: err}
+		return &result{key: key, err: err}
 	}
 	return cfg.Key(key)
 }
 
+// Keyf adalah fungsi level package yang mengembalikan result untuk key yang dibentuk dengan fmt.Sprintf
+func Keyf(format string, args ...interface{}) *result {
+	return Key(fmt.Sprintf(format, args...))
+}
+
+// Getf adalah fungsi level package yang mengambil nilai string untuk key yang dibentuk dengan fmt.Sprintf
+func Getf(format string, args ...interface{}) string {
+	return Get(fmt.Sprintf(format, args...))
+}
+
 // String mengambil nilai environment variable sebagai string
 func String(key string, defaultValue ...string) string {
 	return Get(key, defaultValue...)
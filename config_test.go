@@ -2,9 +2,12 @@ package env
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
@@ -817,9 +820,11 @@ func TestDetermineDefaultModeWithFileCombinations(t *testing.T) {
 		t.Fatalf("Failed to get current directory: %v", err)
 	}
 
-	if err := os.Chdir(oldDir); err != nil {
-		t.Fatalf("Failed to change to temp directory: %v", err)
-	}
+	defer func() {
+		if err := os.Chdir(oldDir); err != nil {
+			t.Errorf("Failed to restore original directory: %v", err)
+		}
+	}()
 
 	// Create temp test directory
 	tmpDir := t.TempDir()
@@ -2251,3 +2256,823 @@ func TestLoadNonProductionMissingFileWithWarning(t *testing.T) {
 		t.Errorf("Expected warning '%s', got output: '%s'", expectedWarning, capturedOutput)
 	}
 }
+
+// TestGetfAndKeyf tests formatted key helpers
+func TestGetfAndKeyf(t *testing.T) {
+	os.Setenv("TEST_NODE_0_HOST", "node0.local")
+	os.Setenv("TEST_NODE_1_PORT", "9001")
+	defer func() {
+		os.Unsetenv("TEST_NODE_0_HOST")
+		os.Unsetenv("TEST_NODE_1_PORT")
+	}()
+
+	cfg := &Config{Prefix: "TEST_"}
+
+	if host := cfg.Getf("NODE_%d_HOST", 0); host != "node0.local" {
+		t.Errorf("Getf() expected 'node0.local', got '%s'", host)
+	}
+
+	if port := cfg.Keyf("NODE_%d_PORT", 1).IntDefault(0); port != 9001 {
+		t.Errorf("Keyf().IntDefault() expected 9001, got %d", port)
+	}
+
+	if host := cfg.Getf("NODE_%d_HOST", 5); host != "" {
+		t.Errorf("Getf() for missing key expected empty, got '%s'", host)
+	}
+
+	// Package level functions use the default instance
+	origGetDefaultInstance := getDefaultInstance
+	defer func() { getDefaultInstance = origGetDefaultInstance }()
+	getDefaultInstance = func() (*Config, error) {
+		return cfg, nil
+	}
+
+	if host := Getf("NODE_%d_HOST", 0); host != "node0.local" {
+		t.Errorf("package Getf() expected 'node0.local', got '%s'", host)
+	}
+
+	if key := Keyf("NODE_%d_PORT", 1).key; key != "TEST_NODE_1_PORT" {
+		t.Errorf("package Keyf() expected key 'TEST_NODE_1_PORT', got '%s'", key)
+	}
+}
+
+// TestGetFloat64Clamped tests clamping float values read through Config
+func TestGetFloat64Clamped(t *testing.T) {
+	os.Setenv("TEST_SAMPLE_RATE", "2.5")
+	os.Setenv("TEST_SAMPLE_NAN", "NaN")
+	defer func() {
+		os.Unsetenv("TEST_SAMPLE_RATE")
+		os.Unsetenv("TEST_SAMPLE_NAN")
+	}()
+
+	cfg := &Config{Prefix: "TEST_"}
+
+	if val := cfg.GetFloat64Clamped("SAMPLE_RATE", 0, 1, 0.5); val != 1 {
+		t.Errorf("GetFloat64Clamped() above max expected 1, got %v", val)
+	}
+	if val := cfg.GetFloat64Clamped("SAMPLE_NAN", 0, 1, 0.5); val != 0.5 {
+		t.Errorf("GetFloat64Clamped() with NaN expected 0.5, got %v", val)
+	}
+	if val := cfg.GetFloat64Clamped("SAMPLE_MISSING", 0, 1, 0.5); val != 0.5 {
+		t.Errorf("GetFloat64Clamped() with missing key expected 0.5, got %v", val)
+	}
+}
+
+// TestGetSliceRaw tests raw slice reading through Config
+func TestGetSliceRaw(t *testing.T) {
+	os.Setenv("TEST_ROW", "id, name,,")
+	defer os.Unsetenv("TEST_ROW")
+
+	cfg := &Config{Prefix: "TEST_"}
+
+	expected := []string{"id", " name", "", ""}
+	if val := cfg.GetSliceRaw("ROW", ""); !equalSlices(val, expected) {
+		t.Errorf("GetSliceRaw() expected %q, got %q", expected, val)
+	}
+
+	if val := cfg.GetSliceRaw("MISSING", ",", []string{"d"}); !equalSlices(val, []string{"d"}) {
+		t.Errorf("GetSliceRaw() with missing key expected [d], got %q", val)
+	}
+
+	if val := cfg.GetSliceRaw("MISSING", ","); len(val) != 0 {
+		t.Errorf("GetSliceRaw() with missing key expected empty slice, got %q", val)
+	}
+}
+
+// TestConfigMerge tests combining two configs
+func TestConfigMerge(t *testing.T) {
+	base := &Config{Mode: Production, Prefix: "APP_"}
+	WithSecrets("TOKEN")(base)
+	WithWatchInterval(time.Second)(base)
+
+	override := &Config{Mode: Staging}
+	WithSecrets("PASSWORD")(override)
+	WithFileSecrets()(override)
+
+	merged := base.Merge(override)
+
+	if merged.Mode != Staging {
+		t.Errorf("Merge() expected Mode=%s, got %s", Staging, merged.Mode)
+	}
+	if merged.Prefix != "APP_" {
+		t.Errorf("Merge() expected empty Prefix to keep 'APP_', got '%s'", merged.Prefix)
+	}
+	if merged.watchInterval != time.Second {
+		t.Errorf("Merge() expected watchInterval=1s, got %v", merged.watchInterval)
+	}
+	if !merged.isSecret("APP_TOKEN") || !merged.isSecret("APP_PASSWORD") {
+		t.Error("Merge() should combine secret keys")
+	}
+	if !merged.fileSecrets {
+		t.Error("Merge() should enable file secrets from other")
+	}
+
+	// Inputs are not modified
+	if base.Mode != Production || base.fileSecrets || base.isSecret("APP_PASSWORD") {
+		t.Error("Merge() should not modify the receiver")
+	}
+	if override.Prefix != "" {
+		t.Error("Merge() should not modify other")
+	}
+
+	// Non-empty prefix from other wins
+	if merged := base.Merge(&Config{Prefix: "DB_"}); merged.Prefix != "DB_" || merged.Mode != Production {
+		t.Errorf("Merge() expected Prefix=DB_ and Mode=%s, got %s and %s", Production, merged.Prefix, merged.Mode)
+	}
+
+	// Nil other returns a copy
+	if merged := base.Merge(nil); merged == base || merged.Mode != base.Mode || merged.Prefix != base.Prefix {
+		t.Error("Merge(nil) should return a copy of the receiver")
+	}
+}
+
+// TestGetDurationBounded tests clamping durations read through Config
+func TestGetDurationBounded(t *testing.T) {
+	os.Setenv("TEST_SHUTDOWN_TIMEOUT", "24h")
+	defer os.Unsetenv("TEST_SHUTDOWN_TIMEOUT")
+
+	cfg := &Config{Prefix: "TEST_"}
+
+	if val := cfg.GetDurationBounded("SHUTDOWN_TIMEOUT", time.Second, time.Minute, 10*time.Second); val != time.Minute {
+		t.Errorf("GetDurationBounded() above max expected 1m, got %v", val)
+	}
+	if val := cfg.GetDurationBounded("MISSING", time.Second, time.Minute, 10*time.Second); val != 10*time.Second {
+		t.Errorf("GetDurationBounded() with missing key expected 10s, got %v", val)
+	}
+}
+
+// TestGetPercent tests reading percentages through Config
+func TestGetPercent(t *testing.T) {
+	os.Setenv("TEST_CACHE_RATIO", "25%")
+	os.Setenv("TEST_BAD_RATIO", "lots%")
+	defer func() {
+		os.Unsetenv("TEST_CACHE_RATIO")
+		os.Unsetenv("TEST_BAD_RATIO")
+	}()
+
+	cfg := &Config{Prefix: "TEST_"}
+
+	if val, err := cfg.GetPercent("CACHE_RATIO"); err != nil || val != 0.25 {
+		t.Errorf("GetPercent() expected 0.25, got %v (error: %v)", val, err)
+	}
+	if _, err := cfg.GetPercent("BAD_RATIO"); err == nil {
+		t.Error("GetPercent() with invalid value should fail")
+	}
+	if val, err := cfg.GetPercent("MISSING", 0.5); err != nil || val != 0.5 {
+		t.Errorf("GetPercent() with default expected 0.5, got %v (error: %v)", val, err)
+	}
+	if _, err := cfg.GetPercent("MISSING"); err == nil {
+		t.Error("GetPercent() with missing key should fail")
+	}
+}
+
+// TestGetOrFunc tests lazily computed fallbacks
+func TestGetOrFunc(t *testing.T) {
+	os.Setenv("TEST_TOKEN", "from-env")
+	defer os.Unsetenv("TEST_TOKEN")
+
+	cfg := &Config{Prefix: "TEST_"}
+	calls := 0
+	generate := func() string {
+		calls++
+		return "generated"
+	}
+
+	if val := cfg.GetOrFunc("TOKEN", generate); val != "from-env" || calls != 0 {
+		t.Errorf("GetOrFunc() with value set expected 'from-env' and no call, got '%s' (%d calls)", val, calls)
+	}
+	if val := cfg.GetOrFunc("MISSING", generate); val != "generated" || calls != 1 {
+		t.Errorf("GetOrFunc() with missing key expected 'generated' and 1 call, got '%s' (%d calls)", val, calls)
+	}
+
+	// Package level
+	origGetDefaultInstance := getDefaultInstance
+	defer func() { getDefaultInstance = origGetDefaultInstance }()
+
+	getDefaultInstance = func() (*Config, error) {
+		return cfg, nil
+	}
+	if val := GetOrFunc("TOKEN", generate); val != "from-env" {
+		t.Errorf("package GetOrFunc() expected 'from-env', got '%s'", val)
+	}
+
+	getDefaultInstance = func() (*Config, error) {
+		return nil, fmt.Errorf("mock error")
+	}
+	if val := GetOrFunc("TOKEN", generate); val != "generated" {
+		t.Errorf("package GetOrFunc() with error expected 'generated', got '%s'", val)
+	}
+}
+
+// TestExists tests presence checks for set, empty, and unset variables
+func TestExists(t *testing.T) {
+	os.Setenv("TEST_FEATURE", "on")
+	os.Setenv("TEST_EMPTY", "")
+	os.Unsetenv("TEST_MISSING")
+	defer os.Unsetenv("TEST_FEATURE")
+	defer os.Unsetenv("TEST_EMPTY")
+
+	cfg := &Config{Prefix: "TEST_"}
+	if !cfg.Exists("FEATURE") {
+		t.Error("Exists() expected true for set variable")
+	}
+	if !cfg.Exists("EMPTY") {
+		t.Error("Exists() expected true for variable set to empty string")
+	}
+	if cfg.Exists("MISSING") {
+		t.Error("Exists() expected false for unset variable")
+	}
+
+	// Package level
+	origGetDefaultInstance := getDefaultInstance
+	defer func() { getDefaultInstance = origGetDefaultInstance }()
+
+	getDefaultInstance = func() (*Config, error) {
+		return cfg, nil
+	}
+	if !Exists("EMPTY") {
+		t.Error("package Exists() expected true for variable set to empty string")
+	}
+
+	getDefaultInstance = func() (*Config, error) {
+		return nil, fmt.Errorf("mock error")
+	}
+	if Exists("FEATURE") {
+		t.Error("package Exists() with error expected false")
+	}
+}
+
+// TestGetPairs tests ordered key/value parsing with duplicate keys
+func TestGetPairs(t *testing.T) {
+	os.Setenv("TEST_HEADERS", " X-A : 1 ,X-B:2,invalid,X-A:3,X-URL:http://a:80")
+	defer os.Unsetenv("TEST_HEADERS")
+
+	cfg := &Config{Prefix: "TEST_"}
+	expected := []KeyValue{
+		{Key: "X-A", Value: "1"},
+		{Key: "X-B", Value: "2"},
+		{Key: "X-A", Value: "3"},
+		{Key: "X-URL", Value: "http://a:80"},
+	}
+	if pairs := cfg.GetPairs("HEADERS", ",", ":"); !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("GetPairs() expected %v, got %v", expected, pairs)
+	}
+
+	os.Setenv("TEST_HEADERS", "a=1;b=2")
+	expected = []KeyValue{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}
+	if pairs := cfg.GetPairs("HEADERS", ";", "="); !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("GetPairs() with custom delimiters expected %v, got %v", expected, pairs)
+	}
+
+	if pairs := cfg.GetPairs("MISSING", "", ""); pairs == nil || len(pairs) != 0 {
+		t.Errorf("GetPairs() with missing key expected empty slice, got %v", pairs)
+	}
+}
+
+// TestGetGlob tests expanding glob patterns into matching paths
+func TestGetGlob(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.conf", "b.conf", "c.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0600); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	os.Setenv("TEST_CONFIG_FILES", filepath.Join(dir, "*.conf"))
+	os.Setenv("TEST_NO_MATCH", filepath.Join(dir, "*.yaml"))
+	os.Setenv("TEST_BAD_PATTERN", "[")
+	defer os.Unsetenv("TEST_CONFIG_FILES")
+	defer os.Unsetenv("TEST_NO_MATCH")
+	defer os.Unsetenv("TEST_BAD_PATTERN")
+
+	cfg := &Config{Prefix: "TEST_"}
+	matches, err := cfg.GetGlob("CONFIG_FILES")
+	expected := []string{filepath.Join(dir, "a.conf"), filepath.Join(dir, "b.conf")}
+	if err != nil || !reflect.DeepEqual(matches, expected) {
+		t.Errorf("GetGlob() expected %v, got %v (error: %v)", expected, matches, err)
+	}
+
+	if matches, err := cfg.GetGlob("NO_MATCH"); err != nil || matches == nil || len(matches) != 0 {
+		t.Errorf("GetGlob() without matches expected empty slice, got %v (error: %v)", matches, err)
+	}
+	if matches, err := cfg.GetGlob("MISSING"); err != nil || len(matches) != 0 {
+		t.Errorf("GetGlob() with missing key expected empty slice, got %v (error: %v)", matches, err)
+	}
+	if _, err := cfg.GetGlob("BAD_PATTERN"); err == nil {
+		t.Error("GetGlob() with bad pattern should fail")
+	}
+}
+
+// TestGetTime tests reading timestamps through Config
+func TestGetTime(t *testing.T) {
+	os.Setenv("TEST_RELEASE_DATE", "2024-12-31")
+	defer os.Unsetenv("TEST_RELEASE_DATE")
+
+	cfg := &Config{Prefix: "TEST_"}
+	got, err := cfg.GetTime("RELEASE_DATE")
+	if err != nil || !got.Equal(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("GetTime() expected 2024-12-31, got %v (error: %v)", got, err)
+	}
+	if _, err := cfg.GetTime("RELEASE_DATE", time.RFC3339); err == nil {
+		t.Error("GetTime() with non-matching layout should fail")
+	}
+	if _, err := cfg.GetTime("MISSING"); err == nil {
+		t.Error("GetTime() with missing key should fail")
+	}
+}
+
+// TestGetUintN tests reading unsigned values with explicit width and base
+func TestGetUintN(t *testing.T) {
+	os.Setenv("TEST_PORT_HEX", "1f90")
+	defer os.Unsetenv("TEST_PORT_HEX")
+
+	cfg := &Config{Prefix: "TEST_"}
+	if val, err := cfg.GetUintN("PORT_HEX", 16, 16); err != nil || val != 8080 {
+		t.Errorf("GetUintN() expected 8080, got %d (error: %v)", val, err)
+	}
+	if _, err := cfg.GetUintN("PORT_HEX", 8, 16); err == nil {
+		t.Error("GetUintN() with 8-bit width should overflow")
+	}
+}
+
+// TestGetInferredMap tests type inference for loosely typed maps
+func TestGetInferredMap(t *testing.T) {
+	os.Setenv("TEST_META", "debug:TRUE,retries:3,ratio:0.75,neg:-2,exp:1e3,code:007,name:api,zero:0,inf:inf,ver:1.2.3")
+	defer os.Unsetenv("TEST_META")
+
+	cfg := &Config{Prefix: "TEST_"}
+	expected := map[string]interface{}{
+		"debug":   true,
+		"retries": 3,
+		"ratio":   0.75,
+		"neg":     -2,
+		"exp":     1000.0,
+		"code":    "007",
+		"name":    "api",
+		"zero":    0,
+		"inf":     "inf",
+		"ver":     "1.2.3",
+	}
+	if got := cfg.GetInferredMap("META"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetInferredMap() expected %v, got %v", expected, got)
+	}
+
+	if got := cfg.GetInferredMap("MISSING"); got == nil || len(got) != 0 {
+		t.Errorf("GetInferredMap() with missing key expected empty map, got %v", got)
+	}
+}
+
+// TestGetDurationOrFloat64Or tests error-free getters with fallbacks
+func TestGetDurationOrFloat64Or(t *testing.T) {
+	os.Setenv("TEST_TIMEOUT", "5s")
+	os.Setenv("TEST_BAD_TIMEOUT", "5 minutes")
+	os.Setenv("TEST_RATIO", "0.25")
+	os.Setenv("TEST_BAD_RATIO", "quarter")
+	defer os.Unsetenv("TEST_TIMEOUT")
+	defer os.Unsetenv("TEST_BAD_TIMEOUT")
+	defer os.Unsetenv("TEST_RATIO")
+	defer os.Unsetenv("TEST_BAD_RATIO")
+
+	cfg := &Config{Prefix: "TEST_"}
+	if val := cfg.GetDurationOr("TIMEOUT", time.Second); val != 5*time.Second {
+		t.Errorf("GetDurationOr() expected 5s, got %v", val)
+	}
+	if val := cfg.GetDurationOr("BAD_TIMEOUT", time.Second); val != time.Second {
+		t.Errorf("GetDurationOr() with invalid value expected 1s, got %v", val)
+	}
+	if val := cfg.GetDurationOr("MISSING", time.Second); val != time.Second {
+		t.Errorf("GetDurationOr() with missing key expected 1s, got %v", val)
+	}
+
+	if val := cfg.GetFloat64Or("RATIO", 1); val != 0.25 {
+		t.Errorf("GetFloat64Or() expected 0.25, got %v", val)
+	}
+	if val := cfg.GetFloat64Or("BAD_RATIO", 1); val != 1 {
+		t.Errorf("GetFloat64Or() with invalid value expected 1, got %v", val)
+	}
+	if val := cfg.GetFloat64Or("MISSING", 1); val != 1 {
+		t.Errorf("GetFloat64Or() with missing key expected 1, got %v", val)
+	}
+}
+
+// TestGetWithFallbacks tests trying several keys in order
+func TestGetWithFallbacks(t *testing.T) {
+	os.Setenv("TEST_LEGACY_HOST", "legacy")
+	os.Setenv("TEST_OLD_HOST", "old")
+	defer os.Unsetenv("TEST_LEGACY_HOST")
+	defer os.Unsetenv("TEST_OLD_HOST")
+
+	cfg := &Config{Prefix: "TEST_"}
+	if val := cfg.GetWithFallbacks("HOST", "OLD_HOST", "LEGACY_HOST"); val != "old" {
+		t.Errorf("GetWithFallbacks() expected 'old', got '%s'", val)
+	}
+	if val := cfg.GetWithFallbacks("HOST", "MISSING", "LEGACY_HOST"); val != "legacy" {
+		t.Errorf("GetWithFallbacks() expected 'legacy', got '%s'", val)
+	}
+	if val := cfg.GetWithFallbacks("HOST", "MISSING"); val != "" {
+		t.Errorf("GetWithFallbacks() with nothing set expected empty, got '%s'", val)
+	}
+
+	os.Setenv("TEST_HOST", "primary")
+	defer os.Unsetenv("TEST_HOST")
+	if val := cfg.KeyWithFallbacks("HOST", "OLD_HOST").String(); val != "primary" {
+		t.Errorf("KeyWithFallbacks() expected 'primary', got '%s'", val)
+	}
+	if val := cfg.KeyWithFallbacks("MISSING", "ALSO_MISSING").Default("fallback").String(); val != "fallback" {
+		t.Errorf("KeyWithFallbacks() with Default expected 'fallback', got '%s'", val)
+	}
+}
+
+// TestMustAndMustLoad tests panicking helpers and their messages
+func TestMustAndMustLoad(t *testing.T) {
+	os.Setenv("TEST_MUST_URL", "postgres://db")
+	defer os.Unsetenv("TEST_MUST_URL")
+
+	cfg := &Config{Mode: Staging, Prefix: "TEST_"}
+	if val := cfg.Must("MUST_URL"); val != "postgres://db" {
+		t.Errorf("Must() expected 'postgres://db', got '%s'", val)
+	}
+
+	os.Setenv("TEST_MUST_URL", "postgres://other")
+	if val := cfg.Must("MUST_URL"); val != "postgres://db" {
+		t.Errorf("Must() cached value expected 'postgres://db', got '%s'", val)
+	}
+	cfg.resetMust()
+	if val := cfg.Must("MUST_URL"); val != "postgres://other" {
+		t.Errorf("Must() after reset expected 'postgres://other', got '%s'", val)
+	}
+
+	expectPanic := func(name string, contains []string, fn func()) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				t.Errorf("%s expected panic", name)
+				return
+			}
+			for _, s := range contains {
+				if !strings.Contains(fmt.Sprint(r), s) {
+					t.Errorf("%s panic message expected to contain '%s', got '%v'", name, s, r)
+				}
+			}
+		}()
+		fn()
+	}
+
+	expectPanic("Must", []string{"TEST_MUST_MISSING", "staging"}, func() {
+		cfg.Must("MUST_MISSING")
+	})
+
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	os.Chdir(t.TempDir())
+
+	expectPanic("MustLoad", []string{"production", ".env", "tidak ditemukan"}, func() {
+		MustLoad(WithMode(Production))
+	})
+
+	os.WriteFile(".env", []byte("MUST_LOADED=yes\n"), 0644)
+	defer os.Unsetenv("MUST_LOADED")
+	if loaded := MustLoad(WithMode(Production)); loaded.Get("MUST_LOADED") != "yes" {
+		t.Error("MustLoad() expected to load .env")
+	}
+}
+
+// TestGetBoolPtr tests three-state flags
+func TestGetBoolPtr(t *testing.T) {
+	os.Setenv("TEST_FLAG_ON", "yes")
+	os.Setenv("TEST_FLAG_OFF", "false")
+	os.Setenv("TEST_FLAG_BAD", "maybe")
+	defer os.Unsetenv("TEST_FLAG_ON")
+	defer os.Unsetenv("TEST_FLAG_OFF")
+	defer os.Unsetenv("TEST_FLAG_BAD")
+
+	cfg := &Config{Prefix: "TEST_"}
+	if val := cfg.GetBoolPtr("FLAG_ON"); val == nil || !*val {
+		t.Errorf("GetBoolPtr(FLAG_ON) expected true, got %v", val)
+	}
+	if val := cfg.GetBoolPtr("FLAG_OFF"); val == nil || *val {
+		t.Errorf("GetBoolPtr(FLAG_OFF) expected false, got %v", val)
+	}
+	if val := cfg.GetBoolPtr("FLAG_BAD"); val != nil {
+		t.Errorf("GetBoolPtr(FLAG_BAD) expected nil, got %v", *val)
+	}
+	if val := cfg.GetBoolPtr("FLAG_UNSET"); val != nil {
+		t.Errorf("GetBoolPtr(FLAG_UNSET) expected nil, got %v", *val)
+	}
+}
+
+// TestGetMapFromEnv tests collecting individual variables into a map
+func TestGetMapFromEnv(t *testing.T) {
+	values := map[string]string{
+		"TEST_LABEL_TEAM":  "core",
+		"TEST_LABEL_URL":   "http://a:80/x?y=1,2",
+		"TEST_LABEL_EMPTY": "",
+		"TEST_LABELS":      "not-a-label",
+	}
+	for k, v := range values {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+
+	cfg := &Config{Prefix: "TEST_"}
+	expected := map[string]string{
+		"TEAM":  "core",
+		"URL":   "http://a:80/x?y=1,2",
+		"EMPTY": "",
+	}
+	if got := cfg.GetMapFromEnv("LABEL_"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetMapFromEnv() expected %v, got %v", expected, got)
+	}
+
+	lower := cfg.GetMapFromEnv("LABEL_", true)
+	if lower["team"] != "core" || lower["url"] != "http://a:80/x?y=1,2" {
+		t.Errorf("GetMapFromEnv() with lowercase expected lowercased keys, got %v", lower)
+	}
+
+	if got := cfg.GetMapFromEnv("NOTHING_"); got == nil || len(got) != 0 {
+		t.Errorf("GetMapFromEnv() without matches expected empty map, got %v", got)
+	}
+}
+
+// TestValue tests the generic package-level Value function
+func TestValue(t *testing.T) {
+	origGetDefaultInstance := getDefaultInstance
+	getDefaultInstance = func() (*Config, error) {
+		return &Config{}, nil
+	}
+	defer func() { getDefaultInstance = origGetDefaultInstance }()
+
+	os.Setenv("VALUE_PORT", "9090")
+	os.Setenv("VALUE_DEBUG", "yes")
+	os.Setenv("VALUE_RATIO", "0.5")
+	os.Setenv("VALUE_TIMEOUT", "3s")
+	os.Setenv("VALUE_NAME", "api")
+	defer os.Unsetenv("VALUE_PORT")
+	defer os.Unsetenv("VALUE_DEBUG")
+	defer os.Unsetenv("VALUE_RATIO")
+	defer os.Unsetenv("VALUE_TIMEOUT")
+	defer os.Unsetenv("VALUE_NAME")
+
+	if port, err := Value[int]("VALUE_PORT", 8080); err != nil || port != 9090 {
+		t.Errorf("Value[int] expected 9090, got %d (error: %v)", port, err)
+	}
+	if port, err := Value[int]("VALUE_MISSING", 8080); err != nil || port != 8080 {
+		t.Errorf("Value[int] with default expected 8080, got %d (error: %v)", port, err)
+	}
+	if debug, err := Value[bool]("VALUE_DEBUG"); err != nil || !debug {
+		t.Errorf("Value[bool] expected true, got %v (error: %v)", debug, err)
+	}
+	if ratio, err := Value[float64]("VALUE_RATIO"); err != nil || ratio != 0.5 {
+		t.Errorf("Value[float64] expected 0.5, got %v (error: %v)", ratio, err)
+	}
+	if timeout, err := Value[time.Duration]("VALUE_TIMEOUT"); err != nil || timeout != 3*time.Second {
+		t.Errorf("Value[time.Duration] expected 3s, got %v (error: %v)", timeout, err)
+	}
+	if name, err := Value[string]("VALUE_NAME", "web"); err != nil || name != "api" {
+		t.Errorf("Value[string] expected 'api', got '%s' (error: %v)", name, err)
+	}
+
+	os.Setenv("VALUE_PORT", "abc")
+	if _, err := Value[int]("VALUE_PORT"); err == nil {
+		t.Error("Value[int] with invalid value expected error")
+	}
+	if port, err := Value[int]("VALUE_PORT", 8080); err == nil || port != 8080 {
+		t.Errorf("Value[int] with invalid value expected 8080 and error, got %d (error: %v)", port, err)
+	}
+	if _, err := Value[uint8]("VALUE_PORT"); err == nil {
+		t.Error("Value[uint8] expected unsupported type error")
+	}
+	type myInt int
+	if _, err := Value[myInt]("VALUE_PORT"); err == nil {
+		t.Error("Value[myInt] expected unsupported type error")
+	}
+}
+
+// TestGetSliceIndexedMap tests GetSliceIndexedMap with the config prefix
+func TestGetSliceIndexedMap(t *testing.T) {
+	os.Setenv("APP_REPLICAS", "db1, db2")
+	defer os.Unsetenv("APP_REPLICAS")
+
+	cfg := &Config{Prefix: "APP_"}
+	expected := map[int]string{0: "db1", 1: "db2"}
+	if got := cfg.GetSliceIndexedMap("REPLICAS"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetSliceIndexedMap expected %v, got %v", expected, got)
+	}
+}
+
+// TestDetermineDefaultModeExpandsReferences tests ${...} references in APP_ENV
+func TestDetermineDefaultModeExpandsReferences(t *testing.T) {
+	oldAppEnv, hadAppEnv := os.LookupEnv("APP_ENV")
+	defer func() {
+		if hadAppEnv {
+			os.Setenv("APP_ENV", oldAppEnv)
+		} else {
+			os.Unsetenv("APP_ENV")
+		}
+	}()
+
+	os.Setenv("APP_ENV", "${DEPLOY_STAGE}")
+	os.Setenv("DEPLOY_STAGE", "${PIPELINE_STAGE}")
+	os.Setenv("PIPELINE_STAGE", Staging)
+	defer os.Unsetenv("DEPLOY_STAGE")
+	defer os.Unsetenv("PIPELINE_STAGE")
+
+	if mode := determineDefaultMode(); mode != Staging {
+		t.Errorf("determineDefaultMode with nested reference expected '%s', got '%s'", Staging, mode)
+	}
+
+	os.Setenv("APP_ENV", "${APP_ENV}")
+	if mode := determineDefaultMode(); mode != "${APP_ENV}" {
+		t.Errorf("determineDefaultMode with circular reference expected '${APP_ENV}' unexpanded, got '%s'", mode)
+	}
+}
+
+// TestGetURLParams tests GetURLParams with the config prefix
+func TestGetURLParams(t *testing.T) {
+	os.Setenv("APP_CACHE_URL", "redis://cache:6379?db=3")
+	defer os.Unsetenv("APP_CACHE_URL")
+
+	cfg := &Config{Prefix: "APP_"}
+	params, err := cfg.GetURLParams("CACHE_URL")
+	if err != nil || params.Get("db") != "3" {
+		t.Errorf("GetURLParams expected db=3, got %v (error: %v)", params, err)
+	}
+
+	if _, err := cfg.GetURLParams("MISSING_URL"); err == nil {
+		t.Error("GetURLParams on missing key expected error")
+	}
+}
+
+// TestKeyWithDefaults tests seeding result defaults from a shared map
+func TestKeyWithDefaults(t *testing.T) {
+	os.Setenv("APP_KWD_HOST", "db.local")
+	defer os.Unsetenv("APP_KWD_HOST")
+
+	cfg := &Config{Prefix: "APP_"}
+	defaults := map[string]string{"KWD_HOST": "localhost", "KWD_PORT": "5432"}
+
+	if host := cfg.KeyWithDefaults("KWD_HOST", defaults).String(); host != "db.local" {
+		t.Errorf("KeyWithDefaults should prefer the environment, got %q", host)
+	}
+	if port, err := cfg.KeyWithDefaults("KWD_PORT", defaults).Required().Int(); err != nil || port != 5432 {
+		t.Errorf("KeyWithDefaults from map expected 5432, got %d (error: %v)", port, err)
+	}
+	if name := cfg.KeyWithDefaults("KWD_NAME", defaults).Default("app").String(); name != "app" {
+		t.Errorf("KeyWithDefaults should fall through to Default, got %q", name)
+	}
+	if err := cfg.KeyWithDefaults("KWD_NAME", nil).Required().Err(); err == nil {
+		t.Error("KeyWithDefaults with nil map and missing key expected Required error")
+	}
+}
+
+// TestDetectMode tests the mode and reason reported by DetectMode
+func TestDetectMode(t *testing.T) {
+	oldAppEnv, hadAppEnv := os.LookupEnv("APP_ENV")
+	defer func() {
+		if hadAppEnv {
+			os.Setenv("APP_ENV", oldAppEnv)
+		} else {
+			os.Unsetenv("APP_ENV")
+		}
+	}()
+	os.Unsetenv("APP_ENV")
+
+	tempDir := t.TempDir()
+	oldDir, _ := os.Getwd()
+	os.Chdir(tempDir)
+	defer os.Chdir(oldDir)
+
+	if mode, reason := DetectMode(); mode != Development || !strings.Contains(reason, "tidak ada file") {
+		t.Errorf("DetectMode without files expected development with 'tidak ada file' reason, got '%s' (%s)", mode, reason)
+	}
+
+	os.WriteFile(".env", []byte(""), 0644)
+	if mode, reason := DetectMode(); mode != Production || !strings.Contains(reason, "hanya .env") {
+		t.Errorf("DetectMode with only .env expected production with 'hanya .env' reason, got '%s' (%s)", mode, reason)
+	}
+
+	os.WriteFile(".env.staging", []byte(""), 0644)
+	if mode, _ := DetectMode(); mode != Staging {
+		t.Errorf("DetectMode with .env and .env.staging expected 'staging', got '%s'", mode)
+	}
+
+	os.Setenv("APP_ENV", Development)
+	if mode, reason := DetectMode(); mode != Development || !strings.Contains(reason, "APP_ENV") {
+		t.Errorf("DetectMode with APP_ENV expected development with APP_ENV reason, got '%s' (%s)", mode, reason)
+	}
+}
+
+// TestGetBytes tests GetBytes with and without a default
+func TestGetBytes(t *testing.T) {
+	os.Setenv("APP_CACHE_SIZE", "2MiB")
+	defer os.Unsetenv("APP_CACHE_SIZE")
+
+	cfg := &Config{Prefix: "APP_"}
+	if size, err := cfg.GetBytes("CACHE_SIZE"); err != nil || size != 2<<20 {
+		t.Errorf("GetBytes expected %d, got %d (error: %v)", 2<<20, size, err)
+	}
+	if size, err := cfg.GetBytes("MISSING_SIZE", 1024); err != nil || size != 1024 {
+		t.Errorf("GetBytes with default expected 1024, got %d (error: %v)", size, err)
+	}
+	if _, err := cfg.GetBytes("MISSING_SIZE"); err == nil {
+		t.Error("GetBytes on missing key without default expected error")
+	}
+
+	os.Setenv("APP_HUGE_SIZE", "10000000TB")
+	defer os.Unsetenv("APP_HUGE_SIZE")
+	if size, err := cfg.GetBytes("HUGE_SIZE", 1024); err == nil {
+		t.Errorf("GetBytes on overflowing size expected error, got %d", size)
+	}
+}
+
+// TestGetValidated tests reading and validating a value in one call
+func TestGetValidated(t *testing.T) {
+	errRegion := errors.New("unknown region")
+	validRegion := func(v string) error {
+		if v != "eu-west-1" && v != "us-east-1" {
+			return errRegion
+		}
+		return nil
+	}
+
+	os.Setenv("APP_REGION", "us-east-1")
+	defer os.Unsetenv("APP_REGION")
+
+	cfg := &Config{Prefix: "APP_"}
+	if v, err := cfg.GetValidated("REGION", validRegion); err != nil || v != "us-east-1" {
+		t.Errorf("GetValidated expected 'us-east-1', got '%s' (error: %v)", v, err)
+	}
+	if v, err := cfg.GetValidated("MISSING_REGION", validRegion, "eu-west-1"); err != nil || v != "eu-west-1" {
+		t.Errorf("GetValidated with default expected 'eu-west-1', got '%s' (error: %v)", v, err)
+	}
+	if _, err := cfg.GetValidated("MISSING_REGION", validRegion, "mars-1"); !errors.Is(err, errRegion) {
+		t.Errorf("GetValidated with invalid default expected %v, got %v", errRegion, err)
+	}
+
+	origGetDefaultInstance := getDefaultInstance
+	getDefaultInstance = func() (*Config, error) {
+		return cfg, nil
+	}
+	defer func() { getDefaultInstance = origGetDefaultInstance }()
+
+	if v, err := GetValidated("REGION", validRegion); err != nil || v != "us-east-1" {
+		t.Errorf("Package GetValidated expected 'us-east-1', got '%s' (error: %v)", v, err)
+	}
+}
+
+// TestLoadExportPrefixedFile tests that shell-style "export KEY=value" lines load correctly
+func TestLoadExportPrefixedFile(t *testing.T) {
+	tempDir := t.TempDir()
+	oldDir, _ := os.Getwd()
+	os.Chdir(tempDir)
+	defer os.Chdir(oldDir)
+
+	content := "export EXPORTED_HOST=db.local\n  export\tEXPORTED_PORT=5432\nexport=literal\nexportEXPORTED_FLAG=on\nEXPORTED_PLAIN=plain\n"
+	if err := os.WriteFile(".env.development", []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create .env.development file: %v", err)
+	}
+	keys := []string{"EXPORTED_HOST", "EXPORTED_PORT", "export", "exportEXPORTED_FLAG", "EXPORTED_PLAIN"}
+	for _, key := range keys {
+		defer os.Unsetenv(key)
+	}
+
+	if _, err := New(WithMode(Development)); err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	expected := map[string]string{
+		"EXPORTED_HOST":       "db.local",
+		"EXPORTED_PORT":       "5432",
+		"export":              "literal",
+		"exportEXPORTED_FLAG": "on",
+		"EXPORTED_PLAIN":      "plain",
+	}
+	for k, v := range expected {
+		if got := os.Getenv(k); got != v {
+			t.Errorf("%s expected '%s', got '%s'", k, v, got)
+		}
+	}
+}
+
+// TestGetDurationSum tests GetDurationSum with the config prefix
+func TestGetDurationSum(t *testing.T) {
+	os.Setenv("APP_TOTAL_WINDOW", "1m|30s")
+	defer os.Unsetenv("APP_TOTAL_WINDOW")
+
+	cfg := &Config{Prefix: "APP_"}
+	if got, err := cfg.GetDurationSum("TOTAL_WINDOW", "|"); err != nil || got != 90*time.Second {
+		t.Errorf("GetDurationSum expected 1m30s, got %v (error: %v)", got, err)
+	}
+}
+
+// TestGetSliceMap tests GetSliceMap with default delimiters
+func TestGetSliceMap(t *testing.T) {
+	os.Setenv("APP_ROUTES", "web:a.com|b.com,api:c.com")
+	defer os.Unsetenv("APP_ROUTES")
+
+	cfg := &Config{Prefix: "APP_"}
+	expected := map[string][]string{"web": {"a.com", "b.com"}, "api": {"c.com"}}
+	if got := cfg.GetSliceMap("ROUTES", "", "", ""); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetSliceMap expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,52 @@
+package env
+
+import "context"
+
+// overridesContextKey adalah key context untuk override dari ContextWithOverrides
+type overridesContextKey struct{}
+
+// ContextWithOverrides mengembalikan context turunan yang membawa override nilai environment
+// variable, misalnya per tenant atau per request. Key ditulis lengkap dengan prefix. Override
+// yang sudah ada di ctx tetap berlaku kecuali ditimpa oleh overrides
+func ContextWithOverrides(ctx context.Context, overrides map[string]string) context.Context {
+	merged := make(map[string]string)
+	if existing, ok := ctx.Value(overridesContextKey{}).(map[string]string); ok {
+		for k, v := range existing {
+			merged[k] = v
+		}
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, overridesContextKey{}, merged)
+}
+
+// WithContext mengembalikan Config turunan yang membaca override dari ctx sebelum environment.
+// Urutan prioritasnya: override context, environment proses, lalu nilai dari file .env (yang
+// sudah dimuat ke environment). Config turunan ringan karena tidak memuat ulang file, dan
+// hanya berlaku selama dipakai, misalnya dalam satu request. Config yang diturunkan darinya
+// (misalnya lewat From) tetap membawa override tersebut. Jika ctx tidak membawa override,
+// c dikembalikan apa adanya
+func (c *Config) WithContext(ctx context.Context) *Config {
+	overrides, ok := ctx.Value(overridesContextKey{}).(map[string]string)
+	if !ok || len(overrides) == 0 {
+		return c
+	}
+
+	derived := c.clone()
+	derived.overrides = mergeOverrides(c.overrides, overrides)
+	return derived
+}
+
+// mergeOverrides menggabungkan base dan extra ke map baru, dengan nilai extra diutamakan.
+// Map baru selalu dibuat agar override milik Config lain tidak ikut berubah
+func mergeOverrides(base, extra map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(extra))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range extra {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,112 @@
+package env
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+// TestWithContext tests request-scoped overrides layered over the environment
+func TestWithContext(t *testing.T) {
+	os.Setenv("CTX_DB_HOST", "shared.db")
+	os.Setenv("CTX_PORT", "8080")
+	defer os.Unsetenv("CTX_DB_HOST")
+	defer os.Unsetenv("CTX_PORT")
+
+	base := &Config{Prefix: "CTX_"}
+
+	// No overrides: the same Config is returned
+	if cfg := base.WithContext(context.Background()); cfg != base {
+		t.Error("WithContext without overrides should return the same Config")
+	}
+
+	ctx := ContextWithOverrides(context.Background(), map[string]string{"CTX_DB_HOST": "tenant1.db"})
+	ctx = ContextWithOverrides(ctx, map[string]string{"CTX_TENANT": "tenant1"})
+
+	cfg := base.WithContext(ctx)
+	if val := cfg.Get("DB_HOST"); val != "tenant1.db" {
+		t.Errorf("Context override expected 'tenant1.db', got '%s'", val)
+	}
+	if val := cfg.Get("TENANT"); val != "tenant1" {
+		t.Errorf("Layered context override expected 'tenant1', got '%s'", val)
+	}
+	if val, _ := cfg.GetInt("PORT"); val != 8080 {
+		t.Errorf("Non-overridden key expected 8080, got %d", val)
+	}
+
+	// The base Config is not affected
+	if val := base.Get("DB_HOST"); val != "shared.db" {
+		t.Errorf("Base Config expected 'shared.db', got '%s'", val)
+	}
+
+	var parsed struct {
+		Host string `env:"DB_HOST"`
+	}
+	if err := cfg.Parse(&parsed); err != nil || parsed.Host != "tenant1.db" {
+		t.Errorf("Parse with context expected 'tenant1.db', got '%s' (error: %v)", parsed.Host, err)
+	}
+}
+
+// TestWithContextFrom tests that configs derived from a context Config keep its overrides
+func TestWithContextFrom(t *testing.T) {
+	os.Setenv("CTX_DB_HOST", "shared.db")
+	os.Setenv("APP_DB_HOST", "app.db")
+	defer os.Unsetenv("CTX_DB_HOST")
+	defer os.Unsetenv("APP_DB_HOST")
+
+	ctx := ContextWithOverrides(context.Background(), map[string]string{
+		"CTX_DB_HOST": "tenant1.db",
+		"APP_DB_HOST": "tenant1.app.db",
+	})
+	cfg := (&Config{Prefix: "CTX_"}).WithContext(ctx)
+
+	if val := cfg.From(WithStrictParse()).Get("DB_HOST"); val != "tenant1.db" {
+		t.Errorf("From() on context Config expected 'tenant1.db', got '%s'", val)
+	}
+	if val := cfg.From(WithPrefix("APP_")).Get("DB_HOST"); val != "tenant1.app.db" {
+		t.Errorf("From(WithPrefix) on context Config expected 'tenant1.app.db', got '%s'", val)
+	}
+	if val := cfg.From(WithMode(Staging)).Get("DB_HOST"); val != "tenant1.db" {
+		t.Errorf("From(WithMode) on context Config expected 'tenant1.db', got '%s'", val)
+	}
+}
+
+// TestWithContextMergeAndParseMode tests that Merge and ParseMode keep context overrides
+func TestWithContextMergeAndParseMode(t *testing.T) {
+	base := &Config{overrides: map[string]string{"MO_X": "base", "MO_Y": "base"}}
+	merged := base.Merge(&Config{overrides: map[string]string{"MO_X": "ctx"}})
+	if val := merged.Get("MO_X"); val != "ctx" {
+		t.Errorf("Merge expected other override 'ctx' to win, got '%s'", val)
+	}
+	if val := merged.Get("MO_Y"); val != "base" {
+		t.Errorf("Merge expected base override 'base' to be kept, got '%s'", val)
+	}
+	if base.overrides["MO_X"] != "base" {
+		t.Error("Merge should not modify the overrides of the original Config")
+	}
+
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	os.Chdir(t.TempDir())
+	os.WriteFile(".env.staging", []byte("MO_HOST=staging.db\n"), 0644)
+
+	ctx := ContextWithOverrides(context.Background(), map[string]string{
+		"MO_HOST":   "tenant.db",
+		"MO_TENANT": "tenant1",
+	})
+	cfg := (&Config{Mode: Development}).WithContext(ctx)
+
+	var preview struct {
+		Host   string `env:"MO_HOST"`
+		Tenant string `env:"MO_TENANT"`
+	}
+	if err := cfg.ParseMode(Staging, &preview); err != nil {
+		t.Fatalf("ParseMode failed: %v", err)
+	}
+	if preview.Host != "staging.db" || preview.Tenant != "tenant1" {
+		t.Errorf("ParseMode with context expected {staging.db tenant1}, got %+v", preview)
+	}
+	if cfg.overrides["MO_HOST"] != "tenant.db" {
+		t.Error("ParseMode should not modify the overrides of the context Config")
+	}
+}
@@ -0,0 +1,60 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// DecoderFunc mengubah nilai string dari environment menjadi nilai arbitrer
+type DecoderFunc func(string) (interface{}, error)
+
+// decoders menyimpan decoder yang didaftarkan dengan RegisterDecoder
+var (
+	decoders      = make(map[string]DecoderFunc)
+	decodersMutex sync.RWMutex
+)
+
+// RegisterDecoder mendaftarkan decoder dengan nama tertentu untuk dipakai oleh Parse
+// melalui tag decoder, misalnya `env:"PLUGIN" decoder:"plugin"`. Mendaftarkan ulang
+// nama yang sama akan menimpa decoder sebelumnya
+func RegisterDecoder(name string, fn DecoderFunc) {
+	decodersMutex.Lock()
+	defer decodersMutex.Unlock()
+	decoders[name] = fn
+}
+
+// getDecoder mengambil decoder yang terdaftar
+func getDecoder(name string) (DecoderFunc, bool) {
+	decodersMutex.RLock()
+	defer decodersMutex.RUnlock()
+	fn, ok := decoders[name]
+	return fn, ok
+}
+
+// setDecodedValue mengisi field menggunakan decoder yang terdaftar
+func setDecodedValue(field reflect.Value, name string, value string) error {
+	fn, ok := getDecoder(name)
+	if !ok {
+		return fmt.Errorf("decoder %q is not registered", name)
+	}
+
+	decoded, err := fn(value)
+	if err != nil {
+		return fmt.Errorf("decoder %q failed: %v", name, err)
+	}
+
+	if decoded == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	decodedValue := reflect.ValueOf(decoded)
+	if !decodedValue.Type().AssignableTo(field.Type()) {
+		return fmt.Errorf("decoder %q returned %s, not assignable to %s",
+			name, decodedValue.Type(), field.Type())
+	}
+
+	field.Set(decodedValue)
+	return nil
+}
@@ -0,0 +1,83 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+// testPlugin is a value produced by a registered decoder
+type testPlugin struct {
+	Name string
+}
+
+// TestParseWithRegisteredDecoder tests decoder tags in Parse
+func TestParseWithRegisteredDecoder(t *testing.T) {
+	RegisterDecoder("test_plugin", func(value string) (interface{}, error) {
+		if value == "bad" {
+			return nil, fmt.Errorf("unknown plugin")
+		}
+		return &testPlugin{Name: value}, nil
+	})
+	RegisterDecoder("test_nil", func(value string) (interface{}, error) {
+		return nil, nil
+	})
+	defer func() {
+		decodersMutex.Lock()
+		delete(decoders, "test_plugin")
+		delete(decoders, "test_nil")
+		decodersMutex.Unlock()
+	}()
+
+	os.Setenv("DECODER_PLUGIN", "redis")
+	defer os.Unsetenv("DECODER_PLUGIN")
+
+	// interface{} and concrete pointer fields
+	var config struct {
+		Any    interface{} `env:"DECODER_PLUGIN" decoder:"test_plugin"`
+		Plugin *testPlugin `env:"DECODER_PLUGIN" decoder:"test_plugin"`
+		Nil    interface{} `env:"DECODER_PLUGIN" decoder:"test_nil"`
+		Deflt  *testPlugin `env:"DECODER_MISSING" decoder:"test_plugin" default:"memory"`
+	}
+	if err := Parse(&config); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if p, ok := config.Any.(*testPlugin); !ok || p.Name != "redis" {
+		t.Errorf("Any expected *testPlugin{redis}, got %#v", config.Any)
+	}
+	if config.Plugin == nil || config.Plugin.Name != "redis" {
+		t.Errorf("Plugin expected redis, got %#v", config.Plugin)
+	}
+	if config.Nil != nil {
+		t.Errorf("Nil expected nil, got %#v", config.Nil)
+	}
+	if config.Deflt == nil || config.Deflt.Name != "memory" {
+		t.Errorf("Deflt expected memory, got %#v", config.Deflt)
+	}
+
+	// Unregistered decoder
+	var unregistered struct {
+		Value interface{} `env:"DECODER_PLUGIN" decoder:"missing_decoder"`
+	}
+	err := Parse(&unregistered)
+	if err == nil || !strings.Contains(err.Error(), `"missing_decoder" is not registered`) {
+		t.Errorf("Parse with unregistered decoder expected error, got %v", err)
+	}
+
+	// Decoder error
+	os.Setenv("DECODER_PLUGIN", "bad")
+	if err := Parse(&config); err == nil || !strings.Contains(err.Error(), "unknown plugin") {
+		t.Errorf("Parse with failing decoder expected error, got %v", err)
+	}
+
+	// Non-assignable result
+	os.Setenv("DECODER_PLUGIN", "redis")
+	var wrongType struct {
+		Value string `env:"DECODER_PLUGIN" decoder:"test_plugin"`
+	}
+	if err := Parse(&wrongType); err == nil || !strings.Contains(err.Error(), "not assignable") {
+		t.Errorf("Parse with non-assignable decoder result expected error, got %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// StringPair menyimpan nilai lama dan baru sebuah key hasil Diff
+type StringPair struct {
+	Old string
+	New string
+}
+
+// Diff membandingkan nilai pada struct v dengan nilai efektif dari environment saat ini
+// (termasuk tag default), dan mengembalikan key (dengan prefix) yang nilainya berbeda.
+// Old berisi nilai di struct dan New berisi nilai yang akan didapat jika Parse dijalankan
+// ulang ke struct baru. Nilai field bertag secret:"true" atau key yang ditandai dengan
+// WithSecrets disamarkan.
+// Berguna di handler reload untuk mencatat pengaturan yang berubah
+func (c *Config) Diff(v interface{}) (map[string]StringPair, error) {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expect pointer to struct")
+	}
+
+	elem := val.Elem()
+	diff := make(map[string]StringPair)
+
+	for _, fl := range structLayout(elem.Type()) {
+		current := elem.Field(fl.index)
+
+		prefixedKey := c.fieldKey(fl)
+		value, err := c.lookup(prefixedKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read field %s: %v", fl.field.Name, err)
+		}
+		if value == "" {
+			value = c.fieldDefault(fl, prefixedKey)
+		}
+
+		// Nilai kosong berarti field akan bernilai zero pada struct baru
+		next := reflect.New(fl.field.Type).Elem()
+		if value != "" {
+			if err := c.setField(next, fl, value); err != nil {
+				return nil, fmt.Errorf("failed to set field %s: %v", fl.field.Name, err)
+			}
+		}
+
+		if reflect.DeepEqual(current.Interface(), next.Interface()) {
+			continue
+		}
+
+		pair := StringPair{
+			Old: fmt.Sprint(current.Interface()),
+			New: fmt.Sprint(next.Interface()),
+		}
+		if c.isSecretField(fl, prefixedKey) {
+			pair = StringPair{Old: RedactedValue, New: RedactedValue}
+		}
+		diff[prefixedKey] = pair
+	}
+
+	return diff, nil
+}
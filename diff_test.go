@@ -0,0 +1,74 @@
+package env
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// TestDiff tests comparing a parsed struct against the current environment
+func TestDiff(t *testing.T) {
+	os.Setenv("DIFF_HOST", "localhost")
+	os.Setenv("DIFF_PORT", "8080")
+	os.Setenv("DIFF_TIMEOUT", "5s")
+	os.Setenv("DIFF_PASSWORD", "old-secret")
+	defer os.Unsetenv("DIFF_HOST")
+	defer os.Unsetenv("DIFF_PORT")
+	defer os.Unsetenv("DIFF_TIMEOUT")
+	defer os.Unsetenv("DIFF_PASSWORD")
+
+	type diffConfig struct {
+		Host     string        `env:"HOST"`
+		Port     int           `env:"PORT"`
+		Timeout  time.Duration `env:"TIMEOUT"`
+		Level    string        `env:"LEVEL" default:"info"`
+		Password string        `env:"PASSWORD"`
+	}
+
+	cfg := (&Config{Prefix: "DIFF_"}).From(WithSecrets("PASSWORD"))
+	var current diffConfig
+	if err := cfg.Parse(&current); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	diff, err := cfg.Diff(&current)
+	if err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+	if len(diff) != 0 {
+		t.Errorf("Diff() right after Parse expected no changes, got %v", diff)
+	}
+
+	os.Setenv("DIFF_PORT", "9090")
+	os.Setenv("DIFF_TIMEOUT", "5000ms") // same duration, different spelling
+	os.Setenv("DIFF_PASSWORD", "new-secret")
+	os.Unsetenv("DIFF_HOST")
+
+	diff, err = cfg.Diff(&current)
+	if err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+
+	expected := map[string]StringPair{
+		"DIFF_PORT":     {Old: "8080", New: "9090"},
+		"DIFF_HOST":     {Old: "localhost", New: ""},
+		"DIFF_PASSWORD": {Old: RedactedValue, New: RedactedValue},
+	}
+	if len(diff) != len(expected) {
+		t.Errorf("Diff() expected %d changes, got %v", len(expected), diff)
+	}
+	for k, v := range expected {
+		if got, ok := diff[k]; !ok || got != v {
+			t.Errorf("Diff()[%s] expected %+v, got %+v", k, v, got)
+		}
+	}
+
+	os.Setenv("DIFF_PORT", "abc")
+	if _, err := cfg.Diff(&current); err == nil {
+		t.Error("Diff() with invalid value should fail")
+	}
+
+	if _, err := cfg.Diff(current); err == nil {
+		t.Error("Diff() with non-pointer should fail")
+	}
+}
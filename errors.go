@@ -0,0 +1,89 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrRequired adalah penyebab FieldError untuk field wajib yang tidak memiliki nilai
+var ErrRequired = errors.New("required field is not set")
+
+// unsupportedTypeError menandai field yang tipenya tidak dapat diisi oleh Parse,
+// sehingga dapat dilewati dengan WithSkipUnsupported
+type unsupportedTypeError struct {
+	message string
+}
+
+// Error mengembalikan pesan error untuk tipe yang tidak didukung
+func (e *unsupportedTypeError) Error() string {
+	return e.message
+}
+
+// FieldError menjelaskan kegagalan Parse pada satu field
+type FieldError struct {
+	// Field adalah nama field pada struct
+	Field string
+	// Key adalah nama environment variable (dengan prefix)
+	Key string
+	// Err adalah penyebab kegagalan, misalnya ErrRequired atau error konversi
+	Err error
+
+	message string
+}
+
+// Error mengembalikan pesan error untuk field
+func (e *FieldError) Error() string {
+	return e.message
+}
+
+// Unwrap mengembalikan penyebab kegagalan untuk errors.Is dan errors.As
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ParseError dikembalikan oleh Parse jika WithErrorAggregation aktif dan satu atau lebih
+// field gagal, sehingga pemanggil dapat memeriksa setiap kegagalan tanpa mencocokkan string
+type ParseError struct {
+	Fields []FieldError
+}
+
+// Error menggabungkan pesan dari semua field yang gagal
+func (e *ParseError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i := range e.Fields {
+		messages[i] = e.Fields[i].Error()
+	}
+	return fmt.Sprintf("parse failed for %d field(s): %s", len(e.Fields), strings.Join(messages, "; "))
+}
+
+// Unwrap mengembalikan error field pertama agar errors.Is dan errors.As dapat dipakai
+func (e *ParseError) Unwrap() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return &e.Fields[0]
+}
+
+// newFieldError membuat FieldError dengan pesan yang sudah diformat
+func newFieldError(fl fieldLayout, key string, err error, format string, args ...interface{}) *FieldError {
+	return &FieldError{
+		Field:   fl.field.Name,
+		Key:     key,
+		Err:     err,
+		message: fmt.Sprintf(format, args...),
+	}
+}
+
+// collectFieldError mengembalikan fe sebagai error jika WithErrorAggregation tidak aktif,
+// selain itu fe ditambahkan ke fieldErrors agar Parse dapat melanjutkan ke field berikutnya
+func (c *Config) collectFieldError(fieldErrors *[]FieldError, fe *FieldError) error {
+	if fe == nil {
+		return nil
+	}
+	if !c.aggregateErrors {
+		return fe
+	}
+	*fieldErrors = append(*fieldErrors, *fe)
+	return nil
+}
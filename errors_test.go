@@ -0,0 +1,71 @@
+package env
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+// aggregateConfig has several fields that can fail independently
+type aggregateConfig struct {
+	Host string `env:"AGG_HOST" required:"true"`
+	Port int    `env:"AGG_PORT"`
+	Rate int    `env:"AGG_RATE"`
+	Name string `env:"AGG_NAME"`
+}
+
+// TestParseErrorAggregation tests collecting all field failures into a ParseError
+func TestParseErrorAggregation(t *testing.T) {
+	os.Setenv("AGG_PORT", "abc")
+	os.Setenv("AGG_RATE", "1.5")
+	os.Setenv("AGG_NAME", "api")
+	defer os.Unsetenv("AGG_PORT")
+	defer os.Unsetenv("AGG_RATE")
+	defer os.Unsetenv("AGG_NAME")
+
+	// Without aggregation the first failure is returned as a FieldError
+	err := (&Config{}).Parse(&aggregateConfig{})
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "Host" || !errors.Is(err, ErrRequired) {
+		t.Errorf("Parse expected FieldError for Host wrapping ErrRequired, got %v", err)
+	}
+	if err.Error() != "required field Host (AGG_HOST) is not set" {
+		t.Errorf("Parse kept unexpected message: %v", err)
+	}
+
+	var cfg aggregateConfig
+	err = (&Config{}).From(WithErrorAggregation()).Parse(&cfg)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Parse with aggregation expected ParseError, got %v", err)
+	}
+
+	expected := []struct{ field, key string }{
+		{"Host", "AGG_HOST"},
+		{"Port", "AGG_PORT"},
+		{"Rate", "AGG_RATE"},
+	}
+	if len(parseErr.Fields) != len(expected) {
+		t.Fatalf("ParseError expected %d fields, got %v", len(expected), parseErr.Fields)
+	}
+	for i, field := range expected {
+		got := parseErr.Fields[i]
+		if got.Field != field.field || got.Key != field.key || got.Err == nil {
+			t.Errorf("ParseError.Fields[%d] expected %s (%s), got %+v", i, field.field, field.key, got)
+		}
+	}
+
+	// Unwrap exposes the first failure
+	if !errors.Is(err, ErrRequired) {
+		t.Error("errors.Is(ParseError, ErrRequired) expected true")
+	}
+	if !strings.Contains(err.Error(), "3 field(s)") {
+		t.Errorf("ParseError message expected field count, got %v", err)
+	}
+
+	// Valid fields are still populated
+	if cfg.Name != "api" {
+		t.Errorf("Parse with aggregation expected Name 'api', got '%s'", cfg.Name)
+	}
+}
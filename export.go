@@ -0,0 +1,210 @@
+package env
+
+import (
+	"encoding"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// RedactedValue adalah nilai pengganti untuk key rahasia saat diekspor
+const RedactedValue = "******"
+
+// textUnmarshalerType adalah tipe encoding.TextUnmarshaler, dipakai untuk mengenali struct
+// yang dibaca sebagai satu nilai seperti time.Time
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// WriteEnvFile menulis nilai environment variable saat ini ke file dalam format KEY=value.
+// Tanpa keys, semua environment variable yang diawali prefix akan ditulis. Dengan keys,
+// hanya key tersebut (ditambah prefix) yang ditulis. Nilai key yang ditandai dengan
+// WithSecrets disamarkan. File ditulis dengan permission 0600. Tanpa prefix, keys wajib
+// diisi agar seluruh environment proses (termasuk kredensial lain) tidak ikut tertulis
+func (c *Config) WriteEnvFile(path string, keys ...string) error {
+	values := make(map[string]string)
+
+	if len(keys) == 0 && c.Prefix == "" {
+		return fmt.Errorf("WriteEnvFile tanpa prefix membutuhkan daftar key secara eksplisit")
+	}
+
+	if len(keys) > 0 {
+		for _, key := range keys {
+			prefixedKey := c.prependPrefix(key)
+			if value, ok := c.getenv(prefixedKey); ok {
+				values[prefixedKey] = value
+			}
+		}
+	} else {
+		values = c.prefixedValues()
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		value := values[name]
+		if c.isSecret(name) {
+			value = RedactedValue
+		}
+		sb.WriteString(name)
+		sb.WriteString("=")
+		sb.WriteString(quoteEnvValue(value))
+		sb.WriteString("\n")
+	}
+
+	return os.WriteFile(path, []byte(sb.String()), 0600)
+}
+
+// Template menghasilkan template file .env dari struct v untuk onboarding. Setiap field
+// ditulis sebagai komentar berisi key (dengan prefix), nilai default untuk mode saat ini,
+// dan penanda required, diikuti baris assignment kosong, misalnya:
+//
+//	# APP_NAME (default: DefaultApp)
+//	APP_NAME=
+//
+// Field bertag collect ditulis sebagai komentar KEY_* tanpa assignment. Field struct tanpa
+// decoder ditelusuri seperti ParseDotted, sehingga field Host di dalam DB ditulis sebagai
+// APP_DB.HOST. Struct yang mengimplementasikan encoding.TextUnmarshaler, seperti time.Time,
+// ditulis sebagai satu key. Nilai environment saat ini tidak dibaca
+func (c *Config) Template(v interface{}) string {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return ""
+	}
+
+	return strings.Join(c.templateBlocks(t), "\n")
+}
+
+// templateBlocks menghasilkan satu blok template untuk setiap field t, dan masuk ke field
+// struct dengan prefix path yang diperpanjang seperti parseDotted
+func (c *Config) templateBlocks(t reflect.Type) []string {
+	var blocks []string
+	for _, fl := range structLayout(t) {
+		if fl.field.Type.Kind() == reflect.Struct && fl.decoder == "" &&
+			!reflect.PtrTo(fl.field.Type).Implements(textUnmarshalerType) {
+			nested := c.clone()
+			nested.Prefix = c.Prefix + fl.envKey + "."
+			blocks = append(blocks, nested.templateBlocks(fl.field.Type)...)
+			continue
+		}
+
+		if fl.collect != "" {
+			blocks = append(blocks, fmt.Sprintf("# %s* (collect)\n", c.prependPrefix(fl.collect)))
+			continue
+		}
+
+		prefixedKey := c.fieldKey(fl)
+		var notes []string
+		if fl.isRequired(c.strictParse) {
+			notes = append(notes, "required")
+		}
+		if def := fl.defaultValue(c.Mode); def != "" {
+			notes = append(notes, "default: "+def)
+		}
+
+		block := "# " + prefixedKey
+		if len(notes) > 0 {
+			block += " (" + strings.Join(notes, ", ") + ")"
+		}
+		blocks = append(blocks, block+"\n"+prefixedKey+"=\n")
+	}
+	return blocks
+}
+
+// prefixedValues mengembalikan semua environment variable yang diawali prefix. Tanpa prefix
+// hasilnya kosong, karena seluruh environment proses bisa berisi kredensial yang tidak terdaftar
+func (c *Config) prefixedValues() map[string]string {
+	values := make(map[string]string)
+	if c.Prefix == "" {
+		return values
+	}
+	for _, kv := range c.environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 && strings.HasPrefix(parts[0], c.Prefix) {
+			values[parts[0]] = parts[1]
+		}
+	}
+	return values
+}
+
+// Snapshot mengembalikan semua environment variable yang diawali prefix, dengan nilai key
+// yang ditandai WithSecrets disamarkan. Cocok untuk endpoint debug seperti /debug/config.
+// Tanpa prefix hasilnya kosong
+func (c *Config) Snapshot() map[string]string {
+	return c.SnapshotExcept()
+}
+
+// SnapshotExcept sama seperti Snapshot, tetapi key yang cocok dengan exclude dihilangkan
+// sepenuhnya. Exclude dapat berupa nama key atau pola glob (path.Match) seperti APP_SECRET*,
+// dan dicocokkan dengan key lengkap maupun key tanpa prefix. Berbeda dengan key rahasia yang
+// tetap muncul dengan nilai disamarkan, key yang dikecualikan tidak muncul sama sekali
+func (c *Config) SnapshotExcept(exclude ...string) map[string]string {
+	values := c.prefixedValues()
+	for name := range values {
+		if c.isExcluded(name, exclude) {
+			delete(values, name)
+		} else if c.isSecret(name) {
+			values[name] = RedactedValue
+		}
+	}
+	return values
+}
+
+// isExcluded memeriksa apakah key (sudah dengan prefix) cocok dengan salah satu pola exclude
+func (c *Config) isExcluded(prefixedKey string, exclude []string) bool {
+	unprefixed := strings.TrimPrefix(prefixedKey, c.Prefix)
+	for _, pattern := range exclude {
+		for _, name := range []string{prefixedKey, unprefixed} {
+			if name == pattern {
+				return true
+			}
+			if matched, err := path.Match(pattern, name); err == nil && matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isSecret memeriksa apakah key (sudah dengan prefix) ditandai sebagai rahasia
+func (c *Config) isSecret(prefixedKey string) bool {
+	if len(c.secretKeys) == 0 {
+		return false
+	}
+	if c.secretKeys[prefixedKey] {
+		return true
+	}
+	return c.Prefix != "" && strings.HasPrefix(prefixedKey, c.Prefix) &&
+		c.secretKeys[strings.TrimPrefix(prefixedKey, c.Prefix)]
+}
+
+// quoteEnvValue memberi tanda kutip pada nilai yang mengandung spasi atau karakter khusus
+// sesuai aturan dotenv. Kutip tunggal dipakai bila memungkinkan agar tidak terjadi
+// ekspansi variabel, selain itu dipakai kutip ganda dengan escape
+func quoteEnvValue(value string) string {
+	if value == "" || !strings.ContainsAny(value, " \t\r\n#'\"\\$=`") {
+		return value
+	}
+
+	if !strings.ContainsAny(value, "'\r\n") {
+		return "'" + value + "'"
+	}
+
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		`$`, `\$`,
+	)
+	return `"` + replacer.Replace(value) + `"`
+}
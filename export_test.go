@@ -0,0 +1,245 @@
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// TestWriteEnvFile tests exporting values with a prefix and filtered keys
+func TestWriteEnvFile(t *testing.T) {
+	envVars := map[string]string{
+		"EXPORT_NAME":     "my app",
+		"EXPORT_PORT":     "8080",
+		"EXPORT_PASSWORD": "hunter2",
+		"OTHER_KEY":       "ignored",
+	}
+	for k, v := range envVars {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+
+	cfg := &Config{Prefix: "EXPORT_"}
+	WithSecrets("PASSWORD")(cfg)
+
+	path := filepath.Join(t.TempDir(), ".env.snapshot")
+	if err := cfg.WriteEnvFile(path); err != nil {
+		t.Fatalf("WriteEnvFile failed: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read exported file: %v", err)
+	}
+
+	expected := "EXPORT_NAME='my app'\nEXPORT_PASSWORD=******\nEXPORT_PORT=8080\n"
+	if string(content) != expected {
+		t.Errorf("WriteEnvFile() expected:\n%s\ngot:\n%s", expected, content)
+	}
+
+	// Filter to specific keys; missing keys are skipped
+	if err := cfg.WriteEnvFile(path, "PORT", "MISSING"); err != nil {
+		t.Fatalf("WriteEnvFile with keys failed: %v", err)
+	}
+	content, _ = os.ReadFile(path)
+	if string(content) != "EXPORT_PORT=8080\n" {
+		t.Errorf("WriteEnvFile(keys) expected 'EXPORT_PORT=8080', got %q", content)
+	}
+
+	// Invalid path
+	if err := cfg.WriteEnvFile(filepath.Join(t.TempDir(), "missing", "file")); err == nil {
+		t.Error("WriteEnvFile to invalid path should return error")
+	}
+}
+
+// TestWriteEnvFileWithoutPrefix tests that an empty prefix never dumps the whole environment
+func TestWriteEnvFileWithoutPrefix(t *testing.T) {
+	os.Setenv("UNPREFIXED_TOKEN", "leak-me")
+	os.Setenv("UNPREFIXED_PORT", "8080")
+	defer os.Unsetenv("UNPREFIXED_TOKEN")
+	defer os.Unsetenv("UNPREFIXED_PORT")
+
+	cfg := &Config{}
+	path := filepath.Join(t.TempDir(), ".env.snapshot")
+
+	if err := cfg.WriteEnvFile(path); err == nil {
+		t.Error("WriteEnvFile without prefix and keys should return error")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("WriteEnvFile without prefix and keys expected no file, got stat error %v", err)
+	}
+
+	if err := cfg.WriteEnvFile(path, "UNPREFIXED_PORT"); err != nil {
+		t.Fatalf("WriteEnvFile without prefix but with keys failed: %v", err)
+	}
+	content, _ := os.ReadFile(path)
+	if string(content) != "UNPREFIXED_PORT=8080\n" {
+		t.Errorf("WriteEnvFile(keys) without prefix expected 'UNPREFIXED_PORT=8080', got %q", content)
+	}
+
+	if snapshot := cfg.Snapshot(); len(snapshot) != 0 {
+		t.Errorf("Snapshot() without prefix expected empty map, got %v", snapshot)
+	}
+}
+
+// TestQuoteEnvValueRoundTrip tests that quoted values are read back unchanged
+func TestQuoteEnvValueRoundTrip(t *testing.T) {
+	values := []string{
+		"plain",
+		"",
+		"with space",
+		"hash#value",
+		"it's",
+		`say "hi"`,
+		"multi\nline",
+		"price $5",
+		`back\slash`,
+		"it's $HOME\n",
+		"a=b",
+	}
+
+	var sb strings.Builder
+	for i, v := range values {
+		sb.WriteString("KEY")
+		sb.WriteString(string(rune('A' + i)))
+		sb.WriteString("=")
+		sb.WriteString(quoteEnvValue(v))
+		sb.WriteString("\n")
+	}
+
+	parsed, err := godotenv.Unmarshal(sb.String())
+	if err != nil {
+		t.Fatalf("Failed to parse exported content: %v", err)
+	}
+
+	for i, v := range values {
+		key := "KEY" + string(rune('A'+i))
+		if parsed[key] != v {
+			t.Errorf("Round trip of %q expected %q, got %q", key, v, parsed[key])
+		}
+	}
+}
+
+// TestWithSecrets tests secret key registration
+func TestWithSecrets(t *testing.T) {
+	cfg := &Config{Prefix: "APP_"}
+	WithSecrets("TOKEN")(cfg)
+	WithSecrets("PASSWORD")(cfg)
+
+	if !cfg.isSecret("APP_TOKEN") || !cfg.isSecret("APP_PASSWORD") {
+		t.Error("Expected TOKEN and PASSWORD to be secret")
+	}
+	if cfg.isSecret("APP_NAME") {
+		t.Error("Expected NAME not to be secret")
+	}
+
+	derived := cfg.From(WithSecrets("EXTRA"))
+	if !derived.isSecret("APP_TOKEN") || !derived.isSecret("APP_EXTRA") {
+		t.Error("From() should keep existing secrets and add new ones")
+	}
+	if cfg.isSecret("APP_EXTRA") {
+		t.Error("From() should not modify the original config secrets")
+	}
+}
+
+// TestSnapshotExcept tests dumping prefixed values with exclusions and redaction
+func TestSnapshotExcept(t *testing.T) {
+	values := map[string]string{
+		"SNAP_HOST":         "localhost",
+		"SNAP_PASSWORD":     "hunter2",
+		"SNAP_SECRET_KEY":   "abc",
+		"SNAP_SECRET_TOKEN": "def",
+		"SNAP_INTERNAL_ID":  "42",
+	}
+	for k, v := range values {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+
+	cfg := (&Config{Prefix: "SNAP_"}).From(WithSecrets("PASSWORD"))
+
+	all := cfg.Snapshot()
+	if len(all) != len(values) {
+		t.Errorf("Snapshot() expected %d keys, got %v", len(values), all)
+	}
+	if all["SNAP_PASSWORD"] != RedactedValue || all["SNAP_HOST"] != "localhost" {
+		t.Errorf("Snapshot() expected redacted password and plain host, got %v", all)
+	}
+
+	// Full-key glob, unprefixed exact key, and redacted keys stay present
+	snap := cfg.SnapshotExcept("SNAP_SECRET*", "INTERNAL_ID")
+	expected := map[string]string{
+		"SNAP_HOST":     "localhost",
+		"SNAP_PASSWORD": RedactedValue,
+	}
+	if len(snap) != len(expected) {
+		t.Errorf("SnapshotExcept() expected %v, got %v", expected, snap)
+	}
+	for k, v := range expected {
+		if snap[k] != v {
+			t.Errorf("SnapshotExcept()[%s] expected '%s', got '%s'", k, v, snap[k])
+		}
+	}
+
+	// Unprefixed glob
+	if snap := cfg.SnapshotExcept("SECRET_*"); len(snap) != 3 {
+		t.Errorf("SnapshotExcept(SECRET_*) expected 3 keys, got %v", snap)
+	}
+}
+
+// TestTemplate tests generating a .env template from a struct
+func TestTemplate(t *testing.T) {
+	type appConfig struct {
+		Name     string            `env:"NAME" default:"DefaultApp"`
+		Password string            `env:"PASSWORD" required:"true"`
+		Level    string            `env:"LOG_LEVEL" default:"info" defaultProduction:"warn"`
+		Port     int               `env:"PORT"`
+		Labels   map[string]string `collect:"LABEL_"`
+	}
+
+	cfg := &Config{Mode: Production, Prefix: "APP_"}
+	expected := "# APP_NAME (default: DefaultApp)\nAPP_NAME=\n" +
+		"\n# APP_PASSWORD (required)\nAPP_PASSWORD=\n" +
+		"\n# APP_LOG_LEVEL (default: warn)\nAPP_LOG_LEVEL=\n" +
+		"\n# APP_PORT\nAPP_PORT=\n" +
+		"\n# APP_LABEL_* (collect)\n"
+	if got := cfg.Template(&appConfig{}); got != expected {
+		t.Errorf("Template() expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	if got := cfg.Template(appConfig{}); got != expected {
+		t.Error("Template should accept a struct value as well as a pointer")
+	}
+	if got := cfg.Template(42); got != "" {
+		t.Errorf("Template with non-struct expected empty string, got %q", got)
+	}
+
+	// Nested structs are expanded with dotted keys, matching ParseDotted
+	type dbConfig struct {
+		Host string `env:"HOST" default:"localhost"`
+		Port int    `env:"PORT"`
+	}
+	type nestedConfig struct {
+		Name string   `env:"NAME"`
+		DB   dbConfig `env:"DB"`
+	}
+	expected = "# APP_NAME\nAPP_NAME=\n" +
+		"\n# APP_DB.HOST (default: localhost)\nAPP_DB.HOST=\n" +
+		"\n# APP_DB.PORT\nAPP_DB.PORT=\n"
+	if got := cfg.Template(&nestedConfig{}); got != expected {
+		t.Errorf("Template with nested struct expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	// Structs with a text unmarshaler such as time.Time are single values
+	type scheduleConfig struct {
+		StartAt time.Time `env:"START_AT"`
+	}
+	expected = "# APP_START_AT\nAPP_START_AT=\n"
+	if got := cfg.Template(&scheduleConfig{}); got != expected {
+		t.Errorf("Template with time.Time expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,97 @@
+package env
+
+import (
+	"os"
+	"strings"
+	"sync"
+)
+
+// envSnapshot menyimpan salinan environment variable untuk mode freeze
+type envSnapshot struct {
+	mu     sync.RWMutex
+	values map[string]string
+}
+
+// newEnvSnapshot membuat snapshot dari environment proses saat ini
+func newEnvSnapshot() *envSnapshot {
+	s := &envSnapshot{}
+	s.refresh()
+	return s
+}
+
+// refresh memperbarui snapshot dari environment proses saat ini
+func (s *envSnapshot) refresh() {
+	values := make(map[string]string)
+	for _, kv := range os.Environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 {
+			values[parts[0]] = parts[1]
+		}
+	}
+
+	s.mu.Lock()
+	s.values = values
+	s.mu.Unlock()
+}
+
+// lookup mengambil nilai dari snapshot
+func (s *envSnapshot) lookup(key string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.values[key]
+	return value, ok
+}
+
+// environ mengembalikan isi snapshot dalam format KEY=value seperti os.Environ
+func (s *envSnapshot) environ() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	env := make([]string, 0, len(s.values))
+	for k, v := range s.values {
+		env = append(env, k+"="+v)
+	}
+	return env
+}
+
+// freezeIfNeeded membuat snapshot jika WithFreeze aktif dan snapshot belum ada
+func (c *Config) freezeIfNeeded() {
+	if c.freeze && c.snapshot == nil {
+		c.snapshot = newEnvSnapshot()
+	}
+}
+
+// getenv membaca environment variable dari override (nilai file pratinjau ParseMode atau
+// override dari WithContext) jika ada, lalu dari snapshot jika WithFreeze aktif, selain itu
+// langsung dari environment proses
+func (c *Config) getenv(key string) (string, bool) {
+	if value, ok := c.overrides[key]; ok {
+		return value, true
+	}
+	if c.snapshot != nil {
+		return c.snapshot.lookup(key)
+	}
+	return os.LookupEnv(key)
+}
+
+// environ mengembalikan semua environment variable dari snapshot jika WithFreeze aktif,
+// selain itu dari environment proses
+func (c *Config) environ() []string {
+	if c.snapshot != nil {
+		return c.snapshot.environ()
+	}
+	return os.Environ()
+}
+
+// Reload memuat ulang file .env sesuai mode dan, jika WithFreeze aktif, memperbarui
+// snapshot. Seperti Load, nilai yang sudah ada di environment tidak ditimpa.
+// Cache Must ikut dikosongkan
+func (c *Config) Reload() error {
+	if err := c.Load(); err != nil {
+		return err
+	}
+	c.resetMust()
+	if c.snapshot != nil {
+		c.snapshot.refresh()
+	}
+	return nil
+}
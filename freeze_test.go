@@ -0,0 +1,109 @@
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestWithFreeze tests that frozen configs ignore later environment changes
+func TestWithFreeze(t *testing.T) {
+	os.Setenv("FREEZE_VALUE", "initial")
+	defer os.Unsetenv("FREEZE_VALUE")
+	defer os.Unsetenv("FREEZE_LATER")
+
+	live := &Config{Prefix: "FREEZE_"}
+	frozen := live.From(WithFreeze())
+
+	os.Setenv("FREEZE_VALUE", "changed")
+	os.Setenv("FREEZE_LATER", "new")
+
+	if val := live.Get("VALUE"); val != "changed" {
+		t.Errorf("Live config expected 'changed', got '%s'", val)
+	}
+	if val := frozen.Get("VALUE"); val != "initial" {
+		t.Errorf("Frozen config expected 'initial', got '%s'", val)
+	}
+	if val := frozen.Key("LATER").Default("missing").String(); val != "missing" {
+		t.Errorf("Frozen config expected key set later to be missing, got '%s'", val)
+	}
+
+	var parsed struct {
+		Value string `env:"VALUE"`
+	}
+	if err := frozen.Parse(&parsed); err != nil || parsed.Value != "initial" {
+		t.Errorf("Frozen Parse expected 'initial', got '%s' (error: %v)", parsed.Value, err)
+	}
+
+	// Derived configs share the snapshot
+	if val := frozen.From(WithPrefix("FREEZE_")).Get("VALUE"); val != "initial" {
+		t.Errorf("Derived frozen config expected 'initial', got '%s'", val)
+	}
+
+	// Merge with a frozen config freezes the result
+	if merged := live.Merge(&Config{freeze: true}); merged.snapshot == nil || live.snapshot != nil {
+		t.Error("Merge() with frozen config should freeze only the result")
+	}
+}
+
+// TestFreezeReload tests refreshing the snapshot with Reload
+func TestFreezeReload(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current directory: %v", err)
+	}
+	defer func() {
+		if err := os.Chdir(oldDir); err != nil {
+			t.Errorf("Failed to restore original directory: %v", err)
+		}
+	}()
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change to temp directory: %v", err)
+	}
+
+	if err := os.WriteFile(".env.development", []byte("FREEZE_FILE_VALUE=from_file"), 0644); err != nil {
+		t.Fatalf("Failed to create .env.development file: %v", err)
+	}
+	defer os.Unsetenv("FREEZE_FILE_VALUE")
+	defer os.Unsetenv("FREEZE_RELOADED")
+
+	cfg, err := New(WithMode(Development), WithFreeze())
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	// Values loaded from the file are part of the snapshot
+	if val := cfg.Get("FREEZE_FILE_VALUE"); val != "from_file" {
+		t.Errorf("Frozen config expected file value 'from_file', got '%s'", val)
+	}
+
+	os.Setenv("FREEZE_RELOADED", "yes")
+	if val := cfg.Get("FREEZE_RELOADED"); val != "" {
+		t.Errorf("Before Reload expected empty, got '%s'", val)
+	}
+
+	if err := cfg.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if val := cfg.Get("FREEZE_RELOADED"); val != "yes" {
+		t.Errorf("After Reload expected 'yes', got '%s'", val)
+	}
+
+	// WriteEnvFile reads from the snapshot
+	os.Setenv("FREEZE_RELOADED", "changed")
+	path := filepath.Join(tmpDir, "snapshot.env")
+	if err := cfg.WriteEnvFile(path, "FREEZE_RELOADED"); err != nil {
+		t.Fatalf("WriteEnvFile failed: %v", err)
+	}
+	content, _ := os.ReadFile(path)
+	if string(content) != "FREEZE_RELOADED=yes\n" {
+		t.Errorf("WriteEnvFile expected snapshot value, got %q", content)
+	}
+
+	// Reload with invalid mode
+	if err := (&Config{Mode: "invalid_mode"}).Reload(); err == nil {
+		t.Error("Reload with invalid mode should return error")
+	}
+}
@@ -0,0 +1,18 @@
+package env
+
+import "fmt"
+
+// Logger menerima pesan peringatan dari Config. *log.Logger dari package standar
+// memenuhi interface ini
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// logf menulis peringatan ke logger dari WithLogger, atau ke stdout jika tidak diset
+func (c *Config) logf(format string, args ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, args...)
+		return
+	}
+	fmt.Printf(format+"\n", args...)
+}
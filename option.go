@@ -1,5 +1,7 @@
 package env
 
+import "time"
+
 // ConfigOption adalah sebuah option untuk fluent configuration
 type ConfigOption func(*Config)
 
@@ -16,3 +18,249 @@ func WithPrefix(prefix string) ConfigOption {
 		c.Prefix = prefix
 	}
 }
+
+// WithSearchPaths menentukan direktori tempat mencari file .env milik mode saat ini,
+// dicoba secara berurutan. Path boleh ditulis dengan "/" maupun separator milik OS
+func WithSearchPaths(dirs ...string) ConfigOption {
+	return func(c *Config) {
+		c.searchPaths = append([]string(nil), dirs...)
+	}
+}
+
+// WithPrefixFromEnv mengambil prefix dari environment variable envKey, misalnya
+// APP_PREFIX=TENANT1_ untuk binary multi-tenant. Nilai dibaca sekali saat Config dibuat
+// (New setelah file .env dimuat, atau With/From), bukan pada setiap pemanggilan.
+// Jika envKey tidak diset, prefix menjadi kosong
+func WithPrefixFromEnv(envKey string) ConfigOption {
+	return func(c *Config) {
+		c.prefixEnvKey = envKey
+	}
+}
+
+// WithWatchInterval menentukan interval polling yang digunakan oleh Watch
+func WithWatchInterval(interval time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.watchInterval = interval
+	}
+}
+
+// WithDebounce membuat Watch menunggu sampai file tidak berubah selama d sebelum memuat ulang
+// dan memanggil onChange, sehingga beberapa penulisan beruntun saat editor menyimpan hanya
+// menghasilkan satu reload. Perubahan tetap dideteksi per interval polling
+func WithDebounce(d time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.debounce = d
+	}
+}
+
+// WithSecrets menandai key sebagai rahasia sehingga nilainya disamarkan saat diekspor.
+// Key ditulis tanpa prefix
+func WithSecrets(keys ...string) ConfigOption {
+	return func(c *Config) {
+		secretKeys := make(map[string]bool, len(c.secretKeys)+len(keys))
+		for k := range c.secretKeys {
+			secretKeys[k] = true
+		}
+		for _, k := range keys {
+			secretKeys[k] = true
+		}
+		c.secretKeys = secretKeys
+	}
+}
+
+// WithFileSecrets mengaktifkan konvensi *_FILE: jika key X kosong tetapi X_FILE
+// diisi, nilai X dibaca dari isi file tersebut (spasi di awal dan akhir dihapus).
+// Nilai langsung pada X selalu diutamakan dibanding X_FILE
+func WithFileSecrets() ConfigOption {
+	return func(c *Config) {
+		c.fileSecrets = true
+	}
+}
+
+// WithKeyNormalization mengaktifkan normalisasi key: titik dan tanda hubung diganti
+// dengan underscore lalu diubah ke huruf besar, sehingga app.db-host dibaca dari
+// APP_DB_HOST. Berlaku untuk Get, Key, dan Parse
+func WithKeyNormalization() ConfigOption {
+	return func(c *Config) {
+		c.normalizeKeys = true
+	}
+}
+
+// WithStrictParse membuat Parse mewajibkan semua field memiliki nilai (dari environment
+// atau tag default). Field dengan tag optional:"true" atau required:"false" tetap dilewati
+func WithStrictParse() ConfigOption {
+	return func(c *Config) {
+		c.strictParse = true
+	}
+}
+
+// WithFreeze menyimpan snapshot environment saat Config dibuat (setelah file .env dimuat).
+// Semua pembacaan selanjutnya memakai snapshot tersebut sehingga perubahan melalui
+// os.Setenv oleh kode lain diabaikan. Gunakan Reload untuk memperbarui snapshot
+func WithFreeze() ConfigOption {
+	return func(c *Config) {
+		c.freeze = true
+	}
+}
+
+// WithNilEmptySlices membuat GetSlice, GetSliceRaw, result.Slice, dan result.StringListRaw
+// mengembalikan nil (bukan slice kosong) untuk nilai yang kosong atau tidak ada.
+// Berguna saat hasilnya di-marshal ke JSON, karena nil menjadi null sedangkan slice kosong menjadi [].
+// Parse memang tidak pernah mengisi field slice untuk nilai kosong sehingga tetap nil
+func WithNilEmptySlices() ConfigOption {
+	return func(c *Config) {
+		c.nilSlices = true
+	}
+}
+
+// WithNumericBool membuat semua bilangan bulat selain 0 bernilai true (misalnya VERBOSE=2
+// atau -1), sesuai konvensi shell. Token seperti "true" dan "yes" tetap berlaku.
+// Berlaku untuk GetBool, result.Bool, dan field bool pada Parse
+func WithNumericBool() ConfigOption {
+	return func(c *Config) {
+		c.numericBool = true
+	}
+}
+
+// WithUpperKeys mengubah semua key yang diberikan ke Get, Key, dan Parse menjadi huruf
+// besar sebelum prefix ditambahkan, sehingga db_host dibaca dari DB_HOST.
+// Prefix tidak diubah, jadi tulis prefix dalam huruf besar jika diperlukan
+func WithUpperKeys() ConfigOption {
+	return func(c *Config) {
+		c.upperKeys = true
+	}
+}
+
+// WithLocaleNumbers membuang koma pemisah ribuan sebelum membaca bilangan bulat dan float,
+// sehingga "1,000" dibaca sebagai 1000. Hanya berlaku untuk nilai tunggal (GetInt, GetInt64,
+// GetFloat64, result.Int, result.Float64, As, dan field bilangan pada Parse), bukan slice
+// karena koma dipakai sebagai pemisah elemen. Pemisah desimal selalu titik ("1,234.5"), dan
+// koma di luar posisi pemisah ribuan seperti "1,5" atau "12,34,5" menghasilkan error
+func WithLocaleNumbers() ConfigOption {
+	return func(c *Config) {
+		c.localeNumbers = true
+	}
+}
+
+// WithFilePriority membuat Load memakai nilai dari file .env meskipun variabel yang sama
+// sudah diset di environment proses. Secara default environment proses diutamakan, sehingga
+// variabel shell yang tertinggal dapat menutupi nilai di file .env
+func WithFilePriority() ConfigOption {
+	return func(c *Config) {
+		c.filePriority = true
+	}
+}
+
+// WithDecryptor mendekripsi nilai yang diawali EncryptedPrefix ("enc:") menggunakan fn saat
+// nilai dibaca oleh Get, Key, dan Parse, sehingga secret terenkripsi dapat disimpan di
+// file .env. Nilai tanpa penanda dikembalikan apa adanya. Kegagalan dekripsi menghasilkan
+// error pada method yang mengembalikan error
+func WithDecryptor(fn func(ciphertext string) (string, error)) ConfigOption {
+	return func(c *Config) {
+		c.decryptor = fn
+	}
+}
+
+// WithErrorAggregation membuat Parse melanjutkan ke field berikutnya saat sebuah field gagal,
+// lalu mengembalikan semua kegagalan sekaligus sebagai *ParseError
+func WithErrorAggregation() ConfigOption {
+	return func(c *Config) {
+		c.aggregateErrors = true
+	}
+}
+
+// WithLogger mengarahkan peringatan (misalnya file .env yang tidak ditemukan) ke logger.
+// Tanpa opsi ini peringatan ditulis ke stdout
+func WithLogger(logger Logger) ConfigOption {
+	return func(c *Config) {
+		c.logger = logger
+	}
+}
+
+// WithWarnUnexportedTagged membuat Parse menulis peringatan ke logger saat melewati field
+// yang tidak diekspor tetapi memiliki tag env, karena field tersebut tidak akan pernah diisi.
+// Field tetap dilewati seperti biasa
+func WithWarnUnexportedTagged() ConfigOption {
+	return func(c *Config) {
+		c.warnUnexported = true
+	}
+}
+
+// WithExtraFiles membuat Load juga memuat file tambahan setelah file .env sesuai mode,
+// misalnya .env.local. File yang tidak ada dilewati. Key yang sudah diset tidak ditimpa,
+// sehingga file yang dimuat lebih dulu diutamakan, kecuali dengan WithFilePriority di
+// mana file terakhir yang menang
+func WithExtraFiles(paths ...string) ConfigOption {
+	return func(c *Config) {
+		c.extraFiles = paths
+	}
+}
+
+// WithDetectDuplicates membuat Load menulis peringatan ke logger untuk setiap key yang
+// didefinisikan di lebih dari satu file yang dimuat, beserta daftar file-nya
+func WithDetectDuplicates() ConfigOption {
+	return func(c *Config) {
+		if c.duplicateCheck < duplicatesWarn {
+			c.duplicateCheck = duplicatesWarn
+		}
+	}
+}
+
+// WithErrorOnDuplicates sama seperti WithDetectDuplicates, tetapi Load mengembalikan error
+// dan tidak memuat file apa pun jika ada key yang didefinisikan di lebih dari satu file
+func WithErrorOnDuplicates() ConfigOption {
+	return func(c *Config) {
+		c.duplicateCheck = duplicatesError
+	}
+}
+
+// WithSkipUnsupported membuat Parse melewati field dengan tipe yang tidak didukung (misalnya
+// chan, func, atau slice dan map yang tidak didukung) dan menulis peringatan ke logger,
+// alih-alih mengembalikan error. Field tersebut tidak diubah (tetap zero pada struct baru).
+// Berguna untuk struct pihak ketiga yang field-nya diisi di tempat lain
+func WithSkipUnsupported() ConfigOption {
+	return func(c *Config) {
+		c.skipUnsupported = true
+	}
+}
+
+// WithObserver memanggil fn pada setiap pembacaan nilai oleh Get, Key, method bertipe, dan
+// Parse, misalnya untuk metrik key yang sering dibaca atau pembacaan key yang tidak pernah
+// diset. fn dipanggil setelah lookup (termasuk dekripsi) tetapi sebelum konversi tipe,
+// dengan key lengkap (dengan prefix). found bernilai false jika key tidak diset, dan nilai
+// key yang ditandai WithSecrets disamarkan. Tanpa opsi ini tidak ada overhead tambahan
+func WithObserver(fn func(key string, value string, found bool)) ConfigOption {
+	return func(c *Config) {
+		c.observer = fn
+	}
+}
+
+// WithProfiles membuat Load memuat file secara berlapis sebagai pengganti file mode: .env
+// sebagai dasar (jika ada), lalu .env.<profile> untuk setiap profile sesuai urutan, misalnya
+// WithProfiles("base", "aws", "high-memory"). Nilai dari file berikutnya menimpa file
+// sebelumnya, sedangkan environment proses tetap diutamakan kecuali dengan WithFilePriority.
+// File profile pertama wajib ada; profile berikutnya yang tidak ada hanya menghasilkan
+// peringatan. File dari WithExtraFiles dimuat setelah semua profile
+func WithProfiles(names ...string) ConfigOption {
+	return func(c *Config) {
+		c.profiles = names
+	}
+}
+
+// WithRespectEmpty membuat Parse tidak memakai tag default untuk environment variable yang
+// diset kosong secara eksplisit (KEY=), karena dianggap sengaja dikosongkan oleh operator.
+// Variabel yang tidak diset tetap memakai default. Field wajib yang diset kosong tetap error
+func WithRespectEmpty() ConfigOption {
+	return func(c *Config) {
+		c.respectEmpty = true
+	}
+}
+
+// WithConcatFieldPrefix membuat tag prefix pada field digabung dengan prefix Config
+// (misalnya APP_ + DB_ + HOST menjadi APP_DB_HOST). Secara default tag prefix pada field
+// menggantikan prefix Config sehingga field membaca DB_HOST apa adanya
+func WithConcatFieldPrefix() ConfigOption {
+	return func(c *Config) {
+		c.concatPrefix = true
+	}
+}
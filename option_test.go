@@ -3,6 +3,8 @@ package env
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 )
@@ -479,3 +481,745 @@ func TestOptionChaining(t *testing.T) {
 		t.Errorf("Expected Prefix=COMBINED_, got %s", config.Prefix)
 	}
 }
+
+// TestInitializePrefixAppliesToPackageFunctions tests that a prefix set via
+// Initialize is honored by all package-level functions
+func TestInitializePrefixAppliesToPackageFunctions(t *testing.T) {
+	// Save original values
+	origDefaultInstance := defaultInstance
+	origInitErr := initErr
+	defer func() {
+		defaultInstance = origDefaultInstance
+		initErr = origInitErr
+	}()
+
+	envVars := map[string]string{
+		"APP_PORT":    "8080",
+		"APP_DEBUG":   "true",
+		"APP_TIMEOUT": "5s",
+		"APP_HOSTS":   "a,b",
+		"PORT":        "1111",
+	}
+	for k, v := range envVars {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+
+	if err := Initialize(WithMode("development"), WithPrefix("APP_")); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	if val := Get("PORT"); val != "8080" {
+		t.Errorf("Get(PORT) expected '8080' from APP_PORT, got '%s'", val)
+	}
+	if val := Int("PORT"); val != 8080 {
+		t.Errorf("Int(PORT) expected 8080, got %d", val)
+	}
+	if val := Bool("DEBUG"); !val {
+		t.Error("Bool(DEBUG) expected true")
+	}
+	if val := Duration("TIMEOUT"); val.String() != "5s" {
+		t.Errorf("Duration(TIMEOUT) expected 5s, got %v", val)
+	}
+	if val := Slice("HOSTS", ","); !equalSlices(val, []string{"a", "b"}) {
+		t.Errorf("Slice(HOSTS) expected [a b], got %v", val)
+	}
+
+	r := Key("PORT")
+	if r.key != "APP_PORT" || r.String() != "8080" {
+		t.Errorf("Key(PORT) expected APP_PORT=8080, got %s=%s", r.key, r.String())
+	}
+
+	var parsed struct {
+		Port int `env:"PORT"`
+	}
+	if err := Parse(&parsed); err != nil || parsed.Port != 8080 {
+		t.Errorf("Parse expected Port=8080, got %d (error: %v)", parsed.Port, err)
+	}
+
+	// With keeps the singleton prefix unless overridden
+	if val := With(WithMode(Development)).Get("PORT"); val != "8080" {
+		t.Errorf("With().Get(PORT) expected '8080', got '%s'", val)
+	}
+}
+
+// TestKeyPackageLevelErrorKeepsKey tests that Key keeps the key name on error
+func TestKeyPackageLevelErrorKeepsKey(t *testing.T) {
+	origGetDefaultInstance := getDefaultInstance
+	defer func() { getDefaultInstance = origGetDefaultInstance }()
+
+	getDefaultInstance = func() (*Config, error) {
+		return nil, fmt.Errorf("mock error")
+	}
+
+	if r := Key("SOME_KEY"); r.key != "SOME_KEY" {
+		t.Errorf("Key() on error expected key 'SOME_KEY', got '%s'", r.key)
+	}
+}
+
+// TestWithFileSecrets tests reading values from files referenced by *_FILE
+func TestWithFileSecrets(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "db_pass")
+	if err := os.WriteFile(secretPath, []byte("  s3cr3t\n"), 0600); err != nil {
+		t.Fatalf("Failed to create secret file: %v", err)
+	}
+
+	os.Setenv("SECRET_DB_PASSWORD_FILE", secretPath)
+	os.Setenv("SECRET_DB_PORT_FILE", secretPath)
+	os.Setenv("SECRET_DB_PORT", "5432")
+	os.Setenv("SECRET_BROKEN_FILE", filepath.Join(t.TempDir(), "missing"))
+	defer func() {
+		os.Unsetenv("SECRET_DB_PASSWORD_FILE")
+		os.Unsetenv("SECRET_DB_PORT_FILE")
+		os.Unsetenv("SECRET_DB_PORT")
+		os.Unsetenv("SECRET_BROKEN_FILE")
+	}()
+
+	// Disabled by default
+	plain := &Config{Prefix: "SECRET_"}
+	if val := plain.Get("DB_PASSWORD"); val != "" {
+		t.Errorf("Without WithFileSecrets expected empty, got '%s'", val)
+	}
+
+	cfg := plain.From(WithFileSecrets())
+
+	if val := cfg.Get("DB_PASSWORD"); val != "s3cr3t" {
+		t.Errorf("Get() expected 's3cr3t' from file, got '%s'", val)
+	}
+	if val := cfg.Key("DB_PASSWORD").Required().String(); val != "s3cr3t" {
+		t.Errorf("Key() expected 's3cr3t' from file, got '%s'", val)
+	}
+
+	// Direct value wins over _FILE
+	if val, err := cfg.GetInt("DB_PORT"); err != nil || val != 5432 {
+		t.Errorf("GetInt() expected direct value 5432, got %d (error: %v)", val, err)
+	}
+
+	var parsed struct {
+		Password string `env:"DB_PASSWORD"`
+		Port     int    `env:"DB_PORT"`
+	}
+	if err := cfg.Parse(&parsed); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if parsed.Password != "s3cr3t" || parsed.Port != 5432 {
+		t.Errorf("Parse expected {s3cr3t 5432}, got %+v", parsed)
+	}
+
+	// Unreadable file surfaces an error
+	if _, err := cfg.GetInt("BROKEN"); err == nil {
+		t.Error("GetInt() with unreadable secret file should return error")
+	}
+	if err := cfg.Key("BROKEN").Default("x").err; err == nil {
+		t.Error("Key() with unreadable secret file should carry an error")
+	}
+	var broken struct {
+		Value string `env:"BROKEN"`
+	}
+	if err := cfg.Parse(&broken); err == nil {
+		t.Error("Parse with unreadable secret file should return error")
+	}
+	if val := cfg.Get("BROKEN", "fallback"); val != "" {
+		t.Errorf("Get() with unreadable secret file expected empty string instead of default, got '%s'", val)
+	}
+}
+
+// TestWithKeyNormalization tests normalizing dots and dashes in keys
+func TestWithKeyNormalization(t *testing.T) {
+	os.Setenv("NORM_APP_DB_HOST", "db.local")
+	os.Setenv("NORM_APP_DB_PORT", "5432")
+	defer func() {
+		os.Unsetenv("NORM_APP_DB_HOST")
+		os.Unsetenv("NORM_APP_DB_PORT")
+	}()
+
+	cases := map[string]string{
+		"app.db.host": "APP_DB_HOST",
+		"app-db-host": "APP_DB_HOST",
+		"App.Db-Host": "APP_DB_HOST",
+		"APP_DB_HOST": "APP_DB_HOST",
+	}
+	for input, expected := range cases {
+		if got := normalizeKey(input); got != expected {
+			t.Errorf("normalizeKey(%q) expected %q, got %q", input, expected, got)
+		}
+	}
+
+	// Disabled by default
+	plain := &Config{Prefix: "NORM_"}
+	if val := plain.Get("app.db.host"); val != "" {
+		t.Errorf("Without normalization expected empty, got '%s'", val)
+	}
+
+	cfg := plain.From(WithKeyNormalization())
+
+	if val := cfg.Get("app.db.host"); val != "db.local" {
+		t.Errorf("Get(app.db.host) expected 'db.local', got '%s'", val)
+	}
+	if val := cfg.Key("app-db-port").IntDefault(0); val != 5432 {
+		t.Errorf("Key(app-db-port) expected 5432, got %d", val)
+	}
+
+	// The prefix is normalized too
+	lower := &Config{Prefix: "norm."}
+	WithKeyNormalization()(lower)
+	if val := lower.Get("app.db.host"); val != "db.local" {
+		t.Errorf("Get() with lowercase prefix expected 'db.local', got '%s'", val)
+	}
+
+	var parsed struct {
+		Host string `env:"app.db.host"`
+		Port int    `env:"app-db-port"`
+	}
+	if err := cfg.Parse(&parsed); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if parsed.Host != "db.local" || parsed.Port != 5432 {
+		t.Errorf("Parse expected {db.local 5432}, got %+v", parsed)
+	}
+}
+
+// TestWithNilEmptySlices tests returning nil for empty slices
+func TestWithNilEmptySlices(t *testing.T) {
+	os.Setenv("NILSLICE_HOSTS", "a,b")
+	defer os.Unsetenv("NILSLICE_HOSTS")
+
+	// Default stays non-nil
+	plain := &Config{Prefix: "NILSLICE_"}
+	if val := plain.GetSlice("MISSING", ","); val == nil || len(val) != 0 {
+		t.Errorf("GetSlice() default expected non-nil empty slice, got %#v", val)
+	}
+	if val := plain.Key("MISSING").Slice(","); val == nil {
+		t.Error("result.Slice() default expected non-nil empty slice")
+	}
+
+	cfg := plain.From(WithNilEmptySlices())
+
+	if val := cfg.GetSlice("MISSING", ","); val != nil {
+		t.Errorf("GetSlice() expected nil, got %#v", val)
+	}
+	if val := cfg.GetSliceRaw("MISSING", ","); val != nil {
+		t.Errorf("GetSliceRaw() expected nil, got %#v", val)
+	}
+	if val := cfg.Key("MISSING").Slice(","); val != nil {
+		t.Errorf("result.Slice() expected nil, got %#v", val)
+	}
+	if val := cfg.Key("MISSING").StringListRaw(","); val != nil {
+		t.Errorf("result.StringListRaw() expected nil, got %#v", val)
+	}
+
+	// Defaults and present values are unaffected
+	if val := cfg.GetSlice("MISSING", ",", []string{"d"}); !equalSlices(val, []string{"d"}) {
+		t.Errorf("GetSlice() with default expected [d], got %v", val)
+	}
+	if val := cfg.GetSlice("HOSTS", ","); !equalSlices(val, []string{"a", "b"}) {
+		t.Errorf("GetSlice() expected [a b], got %v", val)
+	}
+
+	// Parse leaves missing slice fields nil
+	var parsed struct {
+		Missing []string `env:"MISSING"`
+	}
+	if err := cfg.Parse(&parsed); err != nil || parsed.Missing != nil {
+		t.Errorf("Parse expected nil slice, got %#v (error: %v)", parsed.Missing, err)
+	}
+}
+
+// TestWithNumericBool tests treating any nonzero integer as true
+func TestWithNumericBool(t *testing.T) {
+	values := map[string]string{
+		"NUMBOOL_TWO":   "2",
+		"NUMBOOL_NEG":   "-1",
+		"NUMBOOL_ZERO":  "0",
+		"NUMBOOL_YES":   "yes",
+		"NUMBOOL_WORD":  "maybe",
+		"NUMBOOL_FALSE": "false",
+	}
+	for k, v := range values {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+
+	// Default behavior: only "1" is a truthy number
+	plain := &Config{Prefix: "NUMBOOL_"}
+	if plain.GetBool("TWO") || plain.Key("NEG").Bool() {
+		t.Error("Without WithNumericBool, 2 and -1 should be false")
+	}
+
+	cfg := plain.From(WithNumericBool())
+	expected := map[string]bool{
+		"TWO":   true,
+		"NEG":   true,
+		"ZERO":  false,
+		"YES":   true,
+		"WORD":  false,
+		"FALSE": false,
+	}
+	for k, v := range expected {
+		if got := cfg.GetBool(k); got != v {
+			t.Errorf("GetBool(%s) expected %v, got %v", k, v, got)
+		}
+		if got := cfg.Key(k).Bool(); got != v {
+			t.Errorf("Key(%s).Bool() expected %v, got %v", k, v, got)
+		}
+	}
+
+	var parsed struct {
+		Two  bool `env:"TWO"`
+		Zero bool `env:"ZERO"`
+	}
+	if err := cfg.Parse(&parsed); err != nil || !parsed.Two || parsed.Zero {
+		t.Errorf("Parse expected {true false}, got %+v (error: %v)", parsed, err)
+	}
+}
+
+// TestWithUpperKeys tests uppercasing keys before the prefix is applied
+func TestWithUpperKeys(t *testing.T) {
+	os.Setenv("UPPER_DB_HOST", "db.local")
+	os.Setenv("lower_DB_HOST", "lower-prefix")
+	defer os.Unsetenv("UPPER_DB_HOST")
+	defer os.Unsetenv("lower_DB_HOST")
+
+	plain := &Config{Prefix: "UPPER_"}
+	if val := plain.Get("db_host"); val != "" {
+		t.Errorf("Without WithUpperKeys, db_host should not match, got '%s'", val)
+	}
+
+	cfg := plain.From(WithUpperKeys())
+	if val := cfg.Get("db_host"); val != "db.local" {
+		t.Errorf("Get(db_host) expected 'db.local', got '%s'", val)
+	}
+	if val := cfg.Key("Db_Host").String(); val != "db.local" {
+		t.Errorf("Key(Db_Host) expected 'db.local', got '%s'", val)
+	}
+
+	// The prefix is applied after uppercasing and kept as-is
+	lower := (&Config{Prefix: "lower_"}).From(WithUpperKeys())
+	if val := lower.Get("db_host"); val != "lower-prefix" {
+		t.Errorf("Get(db_host) with lowercase prefix expected 'lower-prefix', got '%s'", val)
+	}
+
+	var parsed struct {
+		Host string `env:"db_host"`
+	}
+	if err := cfg.Parse(&parsed); err != nil || parsed.Host != "db.local" {
+		t.Errorf("Parse expected Host 'db.local', got '%s' (error: %v)", parsed.Host, err)
+	}
+}
+
+// TestWithLocaleNumbers tests stripping grouping commas from scalar numbers
+func TestWithLocaleNumbers(t *testing.T) {
+	os.Setenv("LOCALE_USERS", "1,000")
+	os.Setenv("LOCALE_DEBT", "-12,345")
+	os.Setenv("LOCALE_RATE", "1,234.5")
+	os.Setenv("LOCALE_LIST", "1,2,3")
+	defer os.Unsetenv("LOCALE_USERS")
+	defer os.Unsetenv("LOCALE_DEBT")
+	defer os.Unsetenv("LOCALE_RATE")
+	defer os.Unsetenv("LOCALE_LIST")
+
+	plain := &Config{Prefix: "LOCALE_"}
+	if _, err := plain.GetInt("USERS"); err == nil {
+		t.Error("Without WithLocaleNumbers, '1,000' should fail to parse")
+	}
+
+	cfg := plain.From(WithLocaleNumbers())
+	if val, err := cfg.GetInt("USERS"); err != nil || val != 1000 {
+		t.Errorf("GetInt(USERS) expected 1000, got %d (error: %v)", val, err)
+	}
+	if val, err := cfg.GetInt64("DEBT"); err != nil || val != -12345 {
+		t.Errorf("GetInt64(DEBT) expected -12345, got %d (error: %v)", val, err)
+	}
+	if val, err := cfg.GetFloat64("RATE"); err != nil || val != 1234.5 {
+		t.Errorf("GetFloat64(RATE) expected 1234.5, got %v (error: %v)", val, err)
+	}
+	if val, err := cfg.Key("DEBT").Int(); err != nil || val != -12345 {
+		t.Errorf("Key(DEBT).Int() expected -12345, got %d (error: %v)", val, err)
+	}
+	if val, err := cfg.Key("RATE").Float64(); err != nil || val != 1234.5 {
+		t.Errorf("Key(RATE).Float64() expected 1234.5, got %v (error: %v)", val, err)
+	}
+	if val, err := As[uint16](cfg.Key("USERS")); err != nil || val != 1000 {
+		t.Errorf("As[uint16] expected 1000, got %d (error: %v)", val, err)
+	}
+
+	for _, invalid := range []string{"1,5", "12,34,5", "1234,567", ",123", "1,000,", "1.5,0"} {
+		os.Setenv("LOCALE_INVALID", invalid)
+		if val, err := cfg.GetFloat64("INVALID"); err == nil {
+			t.Errorf("GetFloat64(%q) expected error, got %v", invalid, val)
+		}
+	}
+	os.Unsetenv("LOCALE_INVALID")
+
+	var parsed struct {
+		Users int     `env:"USERS"`
+		Rate  float64 `env:"RATE" min:"0"`
+		List  []int64 `env:"LIST"`
+	}
+	if err := cfg.Parse(&parsed); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if parsed.Users != 1000 || parsed.Rate != 1234.5 {
+		t.Errorf("Parse expected Users 1000 and Rate 1234.5, got %+v", parsed)
+	}
+	// Slices still split on commas
+	if len(parsed.List) != 3 {
+		t.Errorf("Parse expected 3 slice elements, got %v", parsed.List)
+	}
+}
+
+// TestWithPrefixFromEnv tests reading the prefix from another variable at construction
+func TestWithPrefixFromEnv(t *testing.T) {
+	os.Setenv("TENANT_PREFIX", "TENANT1_")
+	os.Setenv("TENANT1_DB_HOST", "tenant1.db")
+	defer os.Unsetenv("TENANT_PREFIX")
+	defer os.Unsetenv("TENANT1_DB_HOST")
+
+	cfg := (&Config{}).From(WithPrefixFromEnv("TENANT_PREFIX"))
+	if cfg.Prefix != "TENANT1_" || cfg.Get("DB_HOST") != "tenant1.db" {
+		t.Errorf("WithPrefixFromEnv expected prefix 'TENANT1_', got '%s'", cfg.Prefix)
+	}
+
+	// Evaluated once at construction, not per call
+	os.Setenv("TENANT_PREFIX", "TENANT2_")
+	if cfg.Prefix != "TENANT1_" {
+		t.Errorf("Prefix should not change after construction, got '%s'", cfg.Prefix)
+	}
+
+	// Unset variable falls back to an empty prefix
+	withPrefix := (&Config{Prefix: "APP_"}).From(WithPrefixFromEnv("TENANT_PREFIX_MISSING"))
+	if withPrefix.Prefix != "" {
+		t.Errorf("WithPrefixFromEnv with unset variable expected empty prefix, got '%s'", withPrefix.Prefix)
+	}
+
+	// New reads the variable after the .env file is loaded
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	tempDir := t.TempDir()
+	os.Chdir(tempDir)
+	os.WriteFile(".env.development", []byte("FILE_TENANT_PREFIX=FROMFILE_\n"), 0644)
+	defer os.Unsetenv("FILE_TENANT_PREFIX")
+
+	fromFile, err := New(WithMode(Development), WithPrefixFromEnv("FILE_TENANT_PREFIX"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if fromFile.Prefix != "FROMFILE_" {
+		t.Errorf("New with WithPrefixFromEnv expected prefix from .env file, got '%s'", fromFile.Prefix)
+	}
+}
+
+// TestWithFilePriority tests precedence between the .env file and the process environment
+func TestWithFilePriority(t *testing.T) {
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	tempDir := t.TempDir()
+	os.Chdir(tempDir)
+	os.WriteFile(".env.development", []byte("PRIORITY_HOST=from-file\n"), 0644)
+
+	// Default: the process environment wins
+	os.Setenv("PRIORITY_HOST", "from-shell")
+	defer os.Unsetenv("PRIORITY_HOST")
+
+	cfg, err := New(WithMode(Development))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if val := cfg.Get("PRIORITY_HOST"); val != "from-shell" {
+		t.Errorf("Default precedence expected 'from-shell', got '%s'", val)
+	}
+	if cfg.Info().FilePriority {
+		t.Error("Info().FilePriority expected false by default")
+	}
+
+	// WithFilePriority: the file wins
+	cfg, err = New(WithMode(Development), WithFilePriority())
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if val := cfg.Get("PRIORITY_HOST"); val != "from-file" {
+		t.Errorf("File precedence expected 'from-file', got '%s'", val)
+	}
+	info := cfg.Info()
+	if !info.FilePriority || info.EnvFile != ".env.development" || info.Mode != Development {
+		t.Errorf("Info() expected file priority for .env.development, got %+v", info)
+	}
+}
+
+// TestWithDecryptor tests transparent decryption of marked values
+func TestWithDecryptor(t *testing.T) {
+	os.Setenv("CRYPT_PASSWORD", "enc:terces")
+	os.Setenv("CRYPT_HOST", "db.local")
+	os.Setenv("CRYPT_BROKEN", "enc:!bad")
+	defer os.Unsetenv("CRYPT_PASSWORD")
+	defer os.Unsetenv("CRYPT_HOST")
+	defer os.Unsetenv("CRYPT_BROKEN")
+
+	reverse := func(ciphertext string) (string, error) {
+		if strings.HasPrefix(ciphertext, "!") {
+			return "", fmt.Errorf("bad ciphertext")
+		}
+		runes := []rune(ciphertext)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return string(runes), nil
+	}
+
+	// Without a decryptor the marker is left untouched
+	plain := &Config{Prefix: "CRYPT_"}
+	if val := plain.Get("PASSWORD"); val != "enc:terces" {
+		t.Errorf("Without WithDecryptor expected raw value, got '%s'", val)
+	}
+
+	cfg := plain.From(WithDecryptor(reverse))
+	if val := cfg.Get("PASSWORD"); val != "secret" {
+		t.Errorf("Get(PASSWORD) expected 'secret', got '%s'", val)
+	}
+	if val := cfg.Key("HOST").String(); val != "db.local" {
+		t.Errorf("Unmarked value expected 'db.local', got '%s'", val)
+	}
+	if err := cfg.Key("BROKEN").Err(); err == nil || !strings.Contains(err.Error(), "CRYPT_BROKEN") {
+		t.Errorf("Key(BROKEN) expected decrypt error naming the key, got %v", err)
+	}
+
+	// A failing decryptor must never fall back to the hardcoded default
+	logger := &recordingLogger{}
+	logged := cfg.From(WithLogger(logger))
+	if val := logged.Get("BROKEN", "devpass"); val != "" {
+		t.Errorf("Get(BROKEN, default) with failing decryptor expected empty string, got '%s'", val)
+	}
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "CRYPT_BROKEN") {
+		t.Errorf("Get(BROKEN) expected one logged decrypt error, got %v", logger.messages)
+	}
+
+	var parsed struct {
+		Password string `env:"PASSWORD"`
+		Broken   string `env:"BROKEN"`
+	}
+	if err := cfg.Parse(&parsed); err == nil || parsed.Password != "secret" {
+		t.Errorf("Parse expected decrypted password and error for broken value, got %+v (error: %v)", parsed, err)
+	}
+}
+
+// TestWithDecryptorFailingGetters tests that getters without an error return never fall back to defaults
+func TestWithDecryptorFailingGetters(t *testing.T) {
+	os.Setenv("FAILDEC_FLAG", "enc:x")
+	os.Setenv("FAILDEC_LABEL_TEAM", "enc:x")
+	defer os.Unsetenv("FAILDEC_FLAG")
+	defer os.Unsetenv("FAILDEC_LABEL_TEAM")
+
+	logger := &recordingLogger{}
+	cfg := &Config{Prefix: "FAILDEC_"}
+	WithLogger(logger)(cfg)
+	WithDecryptor(func(string) (string, error) {
+		return "", fmt.Errorf("broken key")
+	})(cfg)
+
+	if val := cfg.Get("FLAG", "devpass"); val != "" {
+		t.Errorf("Get expected empty string, got '%s'", val)
+	}
+	if val := cfg.GetBool("FLAG", true); val {
+		t.Errorf("GetBool expected false instead of default, got %v", val)
+	}
+	if val := cfg.GetBoolPtr("FLAG"); val != nil {
+		t.Errorf("GetBoolPtr expected nil, got %v", *val)
+	}
+	if val := cfg.GetSlice("FLAG", ",", []string{"default"}); len(val) != 0 {
+		t.Errorf("GetSlice expected empty slice instead of default, got %v", val)
+	}
+	if val := cfg.GetSliceRaw("FLAG", ",", []string{"default"}); len(val) != 0 {
+		t.Errorf("GetSliceRaw expected empty slice instead of default, got %v", val)
+	}
+	if val := cfg.GetMap("FLAG", map[string]string{"k": "default"}); len(val) != 0 {
+		t.Errorf("GetMap expected empty map instead of default, got %v", val)
+	}
+	if val := cfg.GetPairs("FLAG", ",", ":"); len(val) != 0 {
+		t.Errorf("GetPairs expected no pairs, got %v", val)
+	}
+	if val := cfg.GetMapFromEnv("LABEL_"); val["TEAM"] != "" {
+		t.Errorf("GetMapFromEnv expected empty value for TEAM, got '%s'", val["TEAM"])
+	}
+
+	if len(logger.messages) != 8 {
+		t.Errorf("Expected 8 logged decrypt errors, got %d: %v", len(logger.messages), logger.messages)
+	}
+	for _, msg := range logger.messages {
+		if !strings.Contains(msg, "broken key") {
+			t.Errorf("Logged message expected to contain the decrypt error, got '%s'", msg)
+		}
+	}
+}
+
+// TestWithSearchPaths tests locating the .env file across directories with OS separators
+func TestWithSearchPaths(t *testing.T) {
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	tempDir := t.TempDir()
+	os.Chdir(tempDir)
+
+	nested := filepath.Join("config", "env")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	os.WriteFile(filepath.Join(nested, ".env.development"), []byte("SEARCH_PATH_HOST=nested\n"), 0644)
+	defer os.Unsetenv("SEARCH_PATH_HOST")
+
+	// POSIX-style search path with a trailing slash, plus a missing directory first
+	cfg := &Config{Mode: Development, searchPaths: []string{"missing", "config/env/"}}
+	path, err := cfg.envFile()
+	expected := filepath.Join("config", "env", ".env.development")
+	if err != nil || path != expected {
+		t.Errorf("envFile() expected '%s', got '%s' (error: %v)", expected, path, err)
+	}
+
+	loaded, err := New(WithMode(Development), WithSearchPaths("missing", "config/env/"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if val := loaded.Get("SEARCH_PATH_HOST"); val != "nested" {
+		t.Errorf("Load with search paths expected 'nested', got '%s'", val)
+	}
+	if info := loaded.Info(); info.EnvFile != expected {
+		t.Errorf("Info().EnvFile expected '%s', got '%s'", expected, info.EnvFile)
+	}
+
+	// When no directory contains the file, the first search path is reported
+	missing := &Config{Mode: Production, searchPaths: []string{"./a/../b"}}
+	if path, _ := missing.envFile(); path != filepath.Join("b", ".env") {
+		t.Errorf("envFile() without matches expected '%s', got '%s'", filepath.Join("b", ".env"), path)
+	}
+	if _, err := New(WithMode(Production), WithSearchPaths("missing")); err == nil || !strings.Contains(err.Error(), filepath.Join("missing", ".env")) {
+		t.Errorf("New in production without file expected error naming the path, got %v", err)
+	}
+}
+
+// recordingLogger collects messages written through Printf
+type recordingLogger struct {
+	messages []string
+}
+
+// Printf implements Logger
+func (l *recordingLogger) Printf(format string, v ...interface{}) {
+	l.messages = append(l.messages, fmt.Sprintf(format, v...))
+}
+
+// TestWithWarnUnexportedTagged tests warnings for unexported fields carrying an env tag
+func TestWithWarnUnexportedTagged(t *testing.T) {
+	type taggedConfig struct {
+		Host   string `env:"WARN_HOST"`
+		port   int    `env:"WARN_PORT"`
+		secret string
+	}
+
+	logger := &recordingLogger{}
+	var cfg taggedConfig
+	if err := With(WithLogger(logger), WithWarnUnexportedTagged()).Parse(&cfg); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	_, _ = cfg.port, cfg.secret
+
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "port") || !strings.Contains(logger.messages[0], "WARN_PORT") {
+		t.Errorf("Expected one warning about port/WARN_PORT, got %v", logger.messages)
+	}
+
+	logger = &recordingLogger{}
+	if err := With(WithLogger(logger)).Parse(&cfg); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(logger.messages) != 0 {
+		t.Errorf("Expected no warnings without WithWarnUnexportedTagged, got %v", logger.messages)
+	}
+}
+
+// TestWithLoggerMissingFile tests that the missing .env warning goes to the logger
+func TestWithLoggerMissingFile(t *testing.T) {
+	tempDir := t.TempDir()
+	oldDir, _ := os.Getwd()
+	os.Chdir(tempDir)
+	defer os.Chdir(oldDir)
+
+	logger := &recordingLogger{}
+	if _, err := New(WithMode(Development), WithLogger(logger)); err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], ".env.development") {
+		t.Errorf("Expected missing file warning on logger, got %v", logger.messages)
+	}
+}
+
+// TestWithExtraFilesDuplicates tests layered loading with duplicate key detection
+func TestWithExtraFilesDuplicates(t *testing.T) {
+	tempDir := t.TempDir()
+	oldDir, _ := os.Getwd()
+	os.Chdir(tempDir)
+	defer os.Chdir(oldDir)
+
+	os.WriteFile(".env.development", []byte("DUP_SHARED=base\nDUP_BASE=1\n"), 0644)
+	os.WriteFile(".env.local", []byte("DUP_SHARED=local\nDUP_LOCAL=1\n"), 0644)
+	defer os.Unsetenv("DUP_SHARED")
+	defer os.Unsetenv("DUP_BASE")
+	defer os.Unsetenv("DUP_LOCAL")
+
+	logger := &recordingLogger{}
+	if _, err := New(WithMode(Development), WithLogger(logger), WithExtraFiles(".env.local", ".env.missing"), WithDetectDuplicates()); err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if os.Getenv("DUP_SHARED") != "base" || os.Getenv("DUP_LOCAL") != "1" {
+		t.Errorf("Expected both files loaded with the first file winning, got DUP_SHARED=%q DUP_LOCAL=%q", os.Getenv("DUP_SHARED"), os.Getenv("DUP_LOCAL"))
+	}
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "DUP_SHARED") || !strings.Contains(logger.messages[0], ".env.local") {
+		t.Errorf("Expected one duplicate warning for DUP_SHARED, got %v", logger.messages)
+	}
+
+	os.Unsetenv("DUP_SHARED")
+	os.Unsetenv("DUP_LOCAL")
+	_, err := New(WithMode(Development), WithExtraFiles(".env.local"), WithErrorOnDuplicates())
+	if err == nil || !strings.Contains(err.Error(), "DUP_SHARED") || !strings.Contains(err.Error(), ".env.development") {
+		t.Errorf("Expected duplicate error listing key and files, got %v", err)
+	}
+	if os.Getenv("DUP_LOCAL") != "" {
+		t.Error("No file should be loaded when duplicates are an error")
+	}
+}
+
+// TestWithObserver tests that every read is reported to the observer
+func TestWithObserver(t *testing.T) {
+	os.Setenv("OBS_PORT", "8080")
+	os.Setenv("OBS_EMPTY", "")
+	os.Setenv("OBS_TOKEN", "s3cr3t-value")
+	defer os.Unsetenv("OBS_PORT")
+	defer os.Unsetenv("OBS_EMPTY")
+	defer os.Unsetenv("OBS_TOKEN")
+
+	type read struct {
+		key, value string
+		found      bool
+	}
+	var reads []read
+	cfg := &Config{Prefix: "OBS_"}
+	WithSecrets("TOKEN")(cfg)
+	WithObserver(func(key, value string, found bool) {
+		reads = append(reads, read{key, value, found})
+	})(cfg)
+
+	cfg.GetInt("PORT")
+	cfg.Key("MISSING")
+	cfg.Get("EMPTY")
+	cfg.Get("TOKEN")
+
+	expected := []read{
+		{"OBS_PORT", "8080", true},
+		{"OBS_MISSING", "", false},
+		{"OBS_EMPTY", "", true},
+		{"OBS_TOKEN", RedactedValue, true},
+	}
+	if len(reads) != len(expected) {
+		t.Fatalf("Expected %d observed reads, got %v", len(expected), reads)
+	}
+	for i := range expected {
+		if reads[i] != expected[i] {
+			t.Errorf("Read %d expected %+v, got %+v", i, expected[i], reads[i])
+		}
+	}
+}
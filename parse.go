@@ -1,15 +1,236 @@
 package env
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
+
+	"github.com/joho/godotenv"
+)
+
+// fieldRequirement menentukan apakah field wajib diisi saat Parse
+type fieldRequirement int
+
+const (
+	// requirementDefault mengikuti mode strict pada Config
+	requirementDefault fieldRequirement = iota
+	// requirementRequired selalu wajib diisi (tag required:"true")
+	requirementRequired
+	// requirementOptional tidak pernah wajib diisi (tag optional:"true" atau required:"false")
+	requirementOptional
 )
 
-// Parse mengisi struct dari environment variables berdasarkan tag
+// fieldLayout menyimpan informasi field struct yang sudah dibaca dari tag
+type fieldLayout struct {
+	index       int
+	field       reflect.StructField
+	envKey      string
+	ownKey      string
+	defaultTag  string
+	modeDefault map[string]string
+	primitive   bool
+	decoder     string
+	collect     string
+	requirement fieldRequirement
+}
+
+// isRequired memeriksa apakah field wajib diisi. Tag pada field selalu
+// diutamakan dibanding mode strict
+func (fl fieldLayout) isRequired(strict bool) bool {
+	switch fl.requirement {
+	case requirementRequired:
+		return true
+	case requirementOptional:
+		return false
+	default:
+		return strict
+	}
+}
+
+// modeDefaultTags memetakan mode ke nama tag default khusus mode tersebut
+var modeDefaultTags = map[string]string{
+	Production:  "defaultProduction",
+	Staging:     "defaultStaging",
+	Development: "defaultDevelopment",
+}
+
+// parseModeDefaults membaca tag default<Mode> seperti defaultProduction
+func parseModeDefaults(fieldType reflect.StructField) map[string]string {
+	var defaults map[string]string
+	for mode, tag := range modeDefaultTags {
+		if value, ok := fieldType.Tag.Lookup(tag); ok {
+			if defaults == nil {
+				defaults = make(map[string]string)
+			}
+			defaults[mode] = value
+		}
+	}
+	return defaults
+}
+
+// defaultValue mengembalikan nilai default untuk mode, tag default<Mode> diutamakan
+func (fl fieldLayout) defaultValue(mode string) string {
+	if value, ok := fl.modeDefault[mode]; ok {
+		return value
+	}
+	return fl.defaultTag
+}
+
+// fieldDefault mengembalikan nilai default field untuk key yang nilainya kosong. Dengan
+// WithRespectEmpty, key yang diset kosong secara eksplisit tidak memakai default
+func (c *Config) fieldDefault(fl fieldLayout, prefixedKey string) string {
+	if c.respectEmpty {
+		if _, set := c.getenv(prefixedKey); set {
+			return ""
+		}
+	}
+	return fl.defaultValue(c.Mode)
+}
+
+// parseRequirement membaca tag required dan optional. Jika keduanya diisi,
+// optional:"true" diutamakan
+func parseRequirement(fieldType reflect.StructField) fieldRequirement {
+	if optional, err := strconv.ParseBool(fieldType.Tag.Get("optional")); err == nil && optional {
+		return requirementOptional
+	}
+	if required, err := strconv.ParseBool(fieldType.Tag.Get("required")); err == nil {
+		if required {
+			return requirementRequired
+		}
+		return requirementOptional
+	}
+	return requirementDefault
+}
+
+// layoutCache menyimpan []fieldLayout per reflect.Type agar tag tidak dibaca ulang
+var layoutCache sync.Map
+
+// structLayout mengembalikan layout field yang dapat diisi untuk tipe struct t
+func structLayout(t reflect.Type) []fieldLayout {
+	if cached, ok := layoutCache.Load(t); ok {
+		return cached.([]fieldLayout)
+	}
+
+	prefix := structPrefix(t)
+	layout := make([]fieldLayout, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		fieldType := t.Field(i)
+
+		// Field yang tidak diekspor (termasuk field _) tidak dapat diisi
+		if fieldType.PkgPath != "" {
+			continue
+		}
+
+		// Dapatkan tag env
+		envKey := fieldType.Tag.Get("env")
+		if envKey == "" {
+			// Jika tidak ada tag env, gunakan nama field
+			envKey = strings.ToUpper(fieldType.Name)
+		}
+		// Tag prefix pada field membentuk key tersendiri yang dapat menggantikan prefix Config
+		var ownKey string
+		if fieldPrefix := fieldType.Tag.Get("prefix"); fieldPrefix != "" {
+			ownKey = fieldPrefix + envKey
+			envKey = prefix + ownKey
+		} else {
+			envKey = prefix + envKey
+		}
+
+		decoder := fieldType.Tag.Get("decoder")
+
+		collect := fieldType.Tag.Get("collect")
+		if collect != "" {
+			collect = prefix + collect
+		}
+
+		layout = append(layout, fieldLayout{
+			index:       i,
+			field:       fieldType,
+			envKey:      envKey,
+			ownKey:      ownKey,
+			defaultTag:  fieldType.Tag.Get("default"),
+			modeDefault: parseModeDefaults(fieldType),
+			primitive:   decoder == "" && isPrimitiveField(fieldType),
+			decoder:     decoder,
+			collect:     collect,
+			requirement: parseRequirement(fieldType),
+		})
+	}
+
+	layoutCache.Store(t, layout)
+	return layout
+}
+
+// fieldKey mengembalikan key environment lengkap untuk field. Field dengan tag prefix
+// memakai key-nya sendiri tanpa prefix Config, kecuali WithConcatFieldPrefix diaktifkan
+func (c *Config) fieldKey(fl fieldLayout) string {
+	if fl.ownKey == "" || c.concatPrefix {
+		return c.prependPrefix(fl.envKey)
+	}
+
+	key := fl.ownKey
+	if c.upperKeys {
+		key = strings.ToUpper(key)
+	}
+	if c.normalizeKeys {
+		key = normalizeKey(key)
+	}
+	return key
+}
+
+// structPrefix membaca prefix level struct dari tag envPrefix pada field _, misalnya
+// _ struct{} `envPrefix:"SERVICE_"`. Field _ hanya dibaca tag-nya dan tidak pernah diisi
+func structPrefix(t reflect.Type) string {
+	for i := 0; i < t.NumField(); i++ {
+		fieldType := t.Field(i)
+		if fieldType.Name != "_" {
+			continue
+		}
+		if prefix, ok := fieldType.Tag.Lookup("envPrefix"); ok {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// isPrimitiveField memeriksa apakah field dapat diisi langsung tanpa aturan tambahan
+func isPrimitiveField(fieldType reflect.StructField) bool {
+	if fieldType.Type == reflect.TypeOf(time.Duration(0)) {
+		return false
+	}
+
+	switch fieldType.Type.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fieldType.Tag.Get("format") != "si"
+	case reflect.Float32, reflect.Float64:
+		return fieldType.Tag.Get("min") == "" && fieldType.Tag.Get("max") == ""
+	default:
+		return false
+	}
+}
+
+// Finalizer dapat diimplementasikan oleh struct tujuan Parse untuk menghitung field turunan
+// atau memvalidasi beberapa field sekaligus. Finalize dipanggil setelah semua field diisi
+// dan divalidasi, dan error-nya dikembalikan apa adanya oleh Parse
+type Finalizer interface {
+	Finalize() error
+}
+
+// Parse mengisi struct dari environment variables berdasarkan tag.
+// Urutannya: field diisi, validasi tag dijalankan, validator dari RegisterValidator, lalu
+// Finalize jika struct mengimplementasikan Finalizer. Error pertama dikembalikan sebagai
+// *FieldError, atau semua kegagalan sebagai *ParseError jika WithErrorAggregation aktif
+// (Finalize tidak dipanggil jika ada field yang gagal)
 func (c *Config) Parse(v interface{}) error {
 	val := reflect.ValueOf(v)
 	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
@@ -17,41 +238,371 @@ func (c *Config) Parse(v interface{}) error {
 	}
 
 	elem := val.Elem()
-	elemType := elem.Type()
+	if c.warnUnexported {
+		c.warnUnexportedTagged(elem.Type())
+	}
 
-	for i := 0; i < elem.NumField(); i++ {
-		field := elem.Field(i)
-		fieldType := elemType.Field(i)
+	var fieldErrors []FieldError
+	for _, fl := range structLayout(elem.Type()) {
+		if err := c.collectFieldError(&fieldErrors, c.parseField(elem.Field(fl.index), fl)); err != nil {
+			return err
+		}
+	}
 
-		// Dapatkan tag env
-		envTag := fieldType.Tag.Get("env")
-		if envTag == "" {
-			// Jika tidak ada tag env, gunakan nama field
-			envTag = strings.ToUpper(fieldType.Name)
+	return finishParse(v, fieldErrors)
+}
+
+// ParseToMap menjalankan Parse lalu mengembalikan nilai efektif setiap field yang terisi
+// (dari environment atau default) sebagai map key environment (dengan prefix) ke string,
+// misalnya untuk log konfigurasi saat startup. Nilai field bertag secret:"true" atau key
+// yang ditandai WithSecrets disamarkan dengan RedactedValue. Field yang kosong tidak dimuat
+func (c *Config) ParseToMap(v interface{}) (map[string]string, error) {
+	if err := c.Parse(v); err != nil {
+		return nil, err
+	}
+
+	elem := reflect.ValueOf(v).Elem()
+	values := make(map[string]string)
+	for _, fl := range structLayout(elem.Type()) {
+		prefixedKey := c.fieldKey(fl)
+		if fl.collect != "" {
+			prefixedKey = c.prependPrefix(fl.collect)
+		}
+		if !c.fieldTouched(fl, prefixedKey) {
+			continue
 		}
 
-		if !field.CanSet() {
+		if c.isSecretField(fl, prefixedKey) {
+			values[prefixedKey] = RedactedValue
+		} else {
+			values[prefixedKey] = formatFieldValue(elem.Field(fl.index))
+		}
+	}
+	return values, nil
+}
+
+// MissingRequired mengembalikan key (dengan prefix) dari field wajib pada struct v yang saat
+// ini tidak memiliki nilai di environment maupun default, tanpa mengisi field dan tanpa error.
+// Field wajib mengikuti aturan Parse (tag required, optional, dan WithStrictParse). Cocok
+// untuk readiness probe yang melaporkan konfigurasi yang belum diset sebelum Parse dijalankan
+func (c *Config) MissingRequired(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	var missing []string
+	for _, fl := range structLayout(t.Elem()) {
+		if !fl.isRequired(c.strictParse) {
 			continue
 		}
 
-		prefixedKey := c.prependPrefix(envTag)
-		value := os.Getenv(prefixedKey)
+		prefixedKey := c.fieldKey(fl)
+		if fl.collect != "" {
+			prefixedKey = c.prependPrefix(fl.collect)
+		}
+		if !c.fieldTouched(fl, prefixedKey) {
+			missing = append(missing, prefixedKey)
+		}
+	}
+	return missing
+}
+
+// DefaultsOf mengembalikan nilai tag default setiap field pada struct v, dengan key
+// environment (dengan prefix) sebagai key map, tanpa membaca environment. Tag default<Mode>
+// untuk mode saat ini diutamakan, dan field tanpa default tidak dimuat. Berguna untuk
+// dokumentasi yang menampilkan default bawaan kode
+func (c *Config) DefaultsOf(v interface{}) map[string]string {
+	defaults := make(map[string]string)
+
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return defaults
+	}
+
+	for _, fl := range structLayout(t) {
+		if def := fl.defaultValue(c.Mode); def != "" && fl.collect == "" {
+			defaults[c.fieldKey(fl)] = def
+		}
+	}
+	return defaults
+}
+
+// DefaultsOf adalah fungsi level package yang mengembalikan nilai tag default setiap field
+// pada struct v menggunakan prefix dan mode instance default
+func DefaultsOf(v interface{}) map[string]string {
+	cfg, err := getDefaultInstance()
+	if err != nil {
+		cfg = &Config{Mode: determineDefaultMode()}
+	}
+	return cfg.DefaultsOf(v)
+}
 
-		// Dapatkan nilai default dari tag default jika ada
-		defaultTag := fieldType.Tag.Get("default")
-		if value == "" && defaultTag != "" {
-			value = defaultTag
+// isSecretField memeriksa apakah field bertag secret:"true" atau key-nya ditandai WithSecrets
+func (c *Config) isSecretField(fl fieldLayout, prefixedKey string) bool {
+	return fl.field.Tag.Get("secret") == "true" || c.isSecret(prefixedKey)
+}
+
+// fieldTouched memeriksa apakah Parse mengisi field, yaitu ada nilai di environment atau default
+func (c *Config) fieldTouched(fl fieldLayout, prefixedKey string) bool {
+	if fl.collect != "" {
+		return len(c.GetMapFromEnv(fl.collect)) > 0
+	}
+	if value, _ := c.rawLookup(prefixedKey); value != "" {
+		return true
+	}
+	return c.fieldDefault(fl, prefixedKey) != ""
+}
+
+// formatFieldValue mengubah nilai field menjadi string; elemen slice dipisahkan koma
+// dan pointer nil menjadi string kosong
+func formatFieldValue(field reflect.Value) string {
+	switch field.Kind() {
+	case reflect.Ptr:
+		if field.IsNil() {
+			return ""
 		}
+		return formatFieldValue(field.Elem())
+	case reflect.Slice:
+		items := make([]string, field.Len())
+		for i := range items {
+			items[i] = fmt.Sprint(field.Index(i).Interface())
+		}
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprint(field.Interface())
+	}
+}
 
-		// Jika masih kosong, lewati
-		if value == "" {
+// ScanStruct mengisi field yang diekspor pada struct v secara berurutan sesuai deklarasi
+// dari nilai tunggal yang dipisahkan delimiter, misalnya SERVER=host:8080:5 ke struct
+// {Host string; Port int; Weight int}. Setiap bagian di-trim, dan jumlah bagian harus
+// sama dengan jumlah field. Field bool dibaca seperti Parse sehingga WithNumericBool berlaku
+func (c *Config) ScanStruct(key string, v interface{}, delimiter string) error {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expect pointer to struct")
+	}
+
+	prefixedKey := c.prependPrefix(key)
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		return fmt.Errorf("environment variable %s is not set", prefixedKey)
+	}
+
+	if delimiter == "" {
+		delimiter = ","
+	}
+
+	elem := val.Elem()
+	var fields []int
+	for i := 0; i < elem.NumField(); i++ {
+		if elem.Type().Field(i).PkgPath == "" {
+			fields = append(fields, i)
+		}
+	}
+
+	parts := strings.Split(value, delimiter)
+	if len(parts) != len(fields) {
+		return fmt.Errorf("environment variable %s has %d parts, expected %d", prefixedKey, len(parts), len(fields))
+	}
+
+	for i, index := range fields {
+		fieldType := elem.Type().Field(index)
+		part := strings.TrimSpace(parts[i])
+		if fieldType.Type.Kind() == reflect.Bool {
+			elem.Field(index).SetBool(c.parseBool(part))
 			continue
 		}
+		if err := setFieldValue(elem.Field(index), fieldType, part); err != nil {
+			return fmt.Errorf("failed to set field %s from %s: %v", fieldType.Name, prefixedKey, err)
+		}
+	}
+	return nil
+}
+
+// warnUnexportedTagged menulis peringatan untuk setiap field tidak diekspor yang memiliki
+// tag env, karena Parse tidak dapat mengisinya
+func (c *Config) warnUnexportedTagged(t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		fieldType := t.Field(i)
+		if fieldType.PkgPath == "" || fieldType.Name == "_" {
+			continue
+		}
+		if envKey, ok := fieldType.Tag.Lookup("env"); ok {
+			c.logf("Peringatan: field %s.%s tidak diekspor sehingga tag env %q dilewati", t.Name(), fieldType.Name, envKey)
+		}
+	}
+}
+
+// finishParse mengembalikan ParseError jika ada field yang gagal, selain itu menjalankan
+// Finalize jika struct mengimplementasikan Finalizer
+func finishParse(v interface{}, fieldErrors []FieldError) error {
+	if len(fieldErrors) > 0 {
+		return &ParseError{Fields: fieldErrors}
+	}
+
+	if finalizer, ok := v.(Finalizer); ok {
+		return finalizer.Finalize()
+	}
+	return nil
+}
+
+// parseField membaca nilai untuk satu field lalu mengisi dan memvalidasinya
+func (c *Config) parseField(field reflect.Value, fl fieldLayout) *FieldError {
+	if fl.collect != "" {
+		return c.parseCollectField(field, fl)
+	}
+
+	prefixedKey := c.fieldKey(fl)
+	value, err := c.lookup(prefixedKey)
+	if err != nil {
+		return newFieldError(fl, prefixedKey, err, "failed to set field %s: %v", fl.field.Name, err)
+	}
+
+	// Gunakan nilai default dari tag default<Mode> atau default jika ada
+	if value == "" {
+		value = c.fieldDefault(fl, prefixedKey)
+	}
+
+	// Jika masih kosong, lewati kecuali field wajib diisi
+	if value == "" {
+		if fl.isRequired(c.strictParse) {
+			return newFieldError(fl, prefixedKey, ErrRequired, "required field %s (%s) is not set", fl.field.Name, prefixedKey)
+		}
+		if err := validateMinItems(0, fl.field); err != nil {
+			return newFieldError(fl, prefixedKey, err, "invalid field %s (%s): %v", fl.field.Name, prefixedKey, err)
+		}
+		return nil
+	}
+
+	// Set nilai field berdasarkan tipe
+	if err := c.setField(field, fl, value); err != nil {
+		var unsupported *unsupportedTypeError
+		if c.skipUnsupported && errors.As(err, &unsupported) {
+			c.logf("Peringatan: field %s (%s) dilewati: %v", fl.field.Name, prefixedKey, err)
+			return nil
+		}
+		return newFieldError(fl, prefixedKey, err, "failed to set field %s: %v", fl.field.Name, err)
+	}
+
+	if field.Kind() == reflect.Slice {
+		if err := validateMinItems(countItems(field), fl.field); err != nil {
+			return newFieldError(fl, prefixedKey, err, "invalid field %s (%s): %v", fl.field.Name, prefixedKey, err)
+		}
+	}
+
+	if field.Kind() == reflect.String {
+		if err := validateStringLength(field.String(), fl.field); err != nil {
+			return newFieldError(fl, prefixedKey, err, "invalid field %s (%s): %v", fl.field.Name, prefixedKey, err)
+		}
+	}
+
+	if err := runValidators(field, fl.field); err != nil {
+		return newFieldError(fl, prefixedKey, err, "invalid field %s (%s): %v", fl.field.Name, prefixedKey, err)
+	}
+
+	return nil
+}
+
+// parseCollectField mengisi field map[string]string bertag collect dari semua environment
+// variable berawalan prefix tersebut, lihat GetMapFromEnv
+func (c *Config) parseCollectField(field reflect.Value, fl fieldLayout) *FieldError {
+	prefixedKey := c.prependPrefix(fl.collect)
+	if fl.field.Type != reflect.TypeOf(map[string]string(nil)) {
+		err := fmt.Errorf("collect tag requires map[string]string, got %s", fl.field.Type)
+		return newFieldError(fl, prefixedKey, err, "failed to set field %s: %v", fl.field.Name, err)
+	}
+
+	values := c.GetMapFromEnv(fl.collect)
+	if len(values) == 0 {
+		if fl.isRequired(c.strictParse) {
+			return newFieldError(fl, prefixedKey, ErrRequired, "required field %s (%s*) is not set", fl.field.Name, prefixedKey)
+		}
+		return nil
+	}
+
+	field.Set(reflect.ValueOf(values))
+	return nil
+}
+
+// setField mengisi field sesuai layout-nya: decoder, primitif, atau aturan tipe lainnya
+func (c *Config) setField(field reflect.Value, fl fieldLayout, value string) error {
+	if fl.decoder == "" && isNumericScalar(fl.field.Type) {
+		value = c.numberText(value)
+	}
+
+	// Field *bool bernilai nil jika kosong, dan nilai yang tidak dikenali adalah error
+	if fl.decoder == "" && fl.field.Type == reflect.TypeOf((*bool)(nil)) {
+		b, ok := c.lookupBool(value)
+		if !ok {
+			return fmt.Errorf("invalid bool value: %q", value)
+		}
+		field.Set(reflect.ValueOf(&b))
+		return nil
+	}
+
+	switch {
+	case fl.decoder != "":
+		return setDecodedValue(field, fl.decoder, value)
+	case fl.primitive:
+		return c.setPrimitiveValue(field, value)
+	default:
+		return setFieldValue(field, fl.field, value)
+	}
+}
+
+// isNumericScalar memeriksa apakah tipe adalah bilangan tunggal (bukan slice dan bukan time.Duration)
+func isNumericScalar(t reflect.Type) bool {
+	if t == reflect.TypeOf(time.Duration(0)) {
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// setPrimitiveValue mengisi field bertipe primitif secara langsung
+func (c *Config) setPrimitiveValue(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+
+	case reflect.Bool:
+		field.SetBool(c.parseBool(value))
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		intVal, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer value: %v", err)
+		}
+		field.SetInt(intVal)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		uintVal, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unsigned integer value: %v", err)
+		}
+		field.SetUint(uintVal)
 
-		// Set nilai field berdasarkan tipe
-		if err := setFieldValue(field, fieldType, value); err != nil {
-			return fmt.Errorf("failed to set field %s: %v", fieldType.Name, err)
+	default:
+		floatVal, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid float value: %v", err)
 		}
+		field.SetFloat(floatVal)
 	}
 
 	return nil
@@ -67,11 +618,21 @@ func setFieldValue(field reflect.Value, fieldType reflect.StructField, value str
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		// Periksa apakah tipe Duration
 		if fieldType.Type == reflect.TypeOf(time.Duration(0)) {
-			duration, err := time.ParseDuration(value)
+			duration, err := parseDurationFormat(value, fieldType.Tag.Get("format"))
 			if err != nil {
 				return fmt.Errorf("invalid duration value: %v", err)
 			}
+			if err := validateDurationRange(duration, fieldType); err != nil {
+				return err
+			}
 			field.Set(reflect.ValueOf(duration))
+		} else if fieldType.Tag.Get("format") == "si" {
+			// Tag format:"si" mengizinkan jumlah seperti 10k atau 2M
+			intVal, err := parseSI(value)
+			if err != nil {
+				return err
+			}
+			field.SetInt(intVal)
 		} else {
 			intVal, err := strconv.ParseInt(value, 10, 64)
 			if err != nil {
@@ -92,6 +653,9 @@ func setFieldValue(field reflect.Value, fieldType reflect.StructField, value str
 		if err != nil {
 			return fmt.Errorf("invalid float value: %v", err)
 		}
+		if err := validateFloatRange(floatVal, fieldType); err != nil {
+			return err
+		}
 		field.SetFloat(floatVal)
 
 	case reflect.Bool:
@@ -100,17 +664,72 @@ func setFieldValue(field reflect.Value, fieldType reflect.StructField, value str
 		field.SetBool(boolVal)
 
 	case reflect.Slice:
-		if fieldType.Type.Elem().Kind() == reflect.String {
-			parts := strings.Split(value, ",")
+		elemType := fieldType.Type.Elem()
+
+		// Tag format:"glob" mengisi []string dengan path yang cocok dengan pola
+		if elemType.Kind() == reflect.String && fieldType.Tag.Get("format") == "glob" {
+			matches, err := expandGlob(value)
+			if err != nil {
+				return fmt.Errorf("invalid glob pattern: %v", err)
+			}
+			slice := reflect.MakeSlice(fieldType.Type, len(matches), len(matches))
+			for i, match := range matches {
+				slice.Index(i).SetString(match)
+			}
+			field.Set(slice)
+			break
+		}
+
+		// Tag format:"json" mengisi slice (termasuk slice struct) dari array JSON
+		if fieldType.Tag.Get("format") == "json" {
+			slice := reflect.New(fieldType.Type)
+			if err := json.Unmarshal([]byte(value), slice.Interface()); err != nil {
+				return fmt.Errorf("invalid JSON value: %v", err)
+			}
+			field.Set(slice.Elem())
+			break
+		}
+
+		parts := strings.Split(value, ",")
+		slice := reflect.MakeSlice(fieldType.Type, len(parts), len(parts))
+
+		switch {
+		case elemType.Kind() == reflect.String:
 			// Trim space dari setiap elemen
-			slice := reflect.MakeSlice(fieldType.Type, len(parts), len(parts))
 			for i, part := range parts {
 				slice.Index(i).SetString(strings.TrimSpace(part))
 			}
-			field.Set(slice)
-		} else {
-			return fmt.Errorf("unsupported slice type: %s", fieldType.Type.Elem().Kind())
+
+		case elemType == reflect.TypeOf(time.Duration(0)):
+			for i, part := range parts {
+				duration, err := parseDurationFormat(strings.TrimSpace(part), fieldType.Tag.Get("format"))
+				if err != nil {
+					return fmt.Errorf("invalid duration value at index %d: %v", i, err)
+				}
+				slice.Index(i).SetInt(int64(duration))
+			}
+
+		case elemType.Kind() == reflect.Int64:
+			// Tag format:"bytes" mengizinkan ukuran seperti 1MB atau 512KiB
+			isBytes := fieldType.Tag.Get("format") == "bytes"
+			for i, part := range parts {
+				var intVal int64
+				var err error
+				if isBytes {
+					intVal, err = parseBytes(strings.TrimSpace(part))
+				} else {
+					intVal, err = strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+				}
+				if err != nil {
+					return fmt.Errorf("invalid integer value at index %d: %v", i, err)
+				}
+				slice.Index(i).SetInt(intVal)
+			}
+
+		default:
+			return &unsupportedTypeError{fmt.Sprintf("unsupported slice type: %s", elemType.Kind())}
 		}
+		field.Set(slice)
 
 	case reflect.Map:
 		if fieldType.Type.Key().Kind() == reflect.String && fieldType.Type.Elem().Kind() == reflect.String {
@@ -127,17 +746,299 @@ func setFieldValue(field reflect.Value, fieldType reflect.StructField, value str
 			}
 			field.Set(result)
 		} else {
-			return fmt.Errorf("unsupported map type: map[%s]%s",
-				fieldType.Type.Key().Kind(), fieldType.Type.Elem().Kind())
+			return &unsupportedTypeError{fmt.Sprintf("unsupported map type: map[%s]%s",
+				fieldType.Type.Key().Kind(), fieldType.Type.Elem().Kind())}
 		}
 
 	default:
-		return fmt.Errorf("unsupported type: %s", field.Kind())
+		return &unsupportedTypeError{fmt.Sprintf("unsupported type: %s", field.Kind())}
+	}
+
+	return nil
+}
+
+// validateFloatRange memvalidasi nilai float terhadap tag min dan max jika ada
+func validateFloatRange(value float64, fieldType reflect.StructField) error {
+	minTag, maxTag := fieldType.Tag.Get("min"), fieldType.Tag.Get("max")
+	if minTag == "" && maxTag == "" {
+		return nil
+	}
+
+	// NaN dan Inf tidak pernah berada dalam rentang
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("invalid float value: %v", value)
+	}
+
+	if minTag != "" {
+		minValue, err := strconv.ParseFloat(minTag, 64)
+		if err != nil {
+			return fmt.Errorf("invalid min tag: %v", err)
+		}
+		if value < minValue {
+			return fmt.Errorf("value %v is less than min %v", value, minValue)
+		}
+	}
+
+	if maxTag != "" {
+		maxValue, err := strconv.ParseFloat(maxTag, 64)
+		if err != nil {
+			return fmt.Errorf("invalid max tag: %v", err)
+		}
+		if value > maxValue {
+			return fmt.Errorf("value %v is greater than max %v", value, maxValue)
+		}
+	}
+
+	return nil
+}
+
+// validateDurationRange memvalidasi nilai durasi terhadap tag min dan max (misalnya
+// min:"1s" max:"30s") jika ada
+func validateDurationRange(value time.Duration, fieldType reflect.StructField) error {
+	if minTag := fieldType.Tag.Get("min"); minTag != "" {
+		minValue, err := time.ParseDuration(minTag)
+		if err != nil {
+			return fmt.Errorf("invalid min tag: %v", err)
+		}
+		if value < minValue {
+			return fmt.Errorf("duration %v is less than min %v", value, minValue)
+		}
+	}
+
+	if maxTag := fieldType.Tag.Get("max"); maxTag != "" {
+		maxValue, err := time.ParseDuration(maxTag)
+		if err != nil {
+			return fmt.Errorf("invalid max tag: %v", err)
+		}
+		if value > maxValue {
+			return fmt.Errorf("duration %v is greater than max %v", value, maxValue)
+		}
+	}
+
+	return nil
+}
+
+// validateMinItems memvalidasi jumlah elemen slice terhadap tag minItems jika ada
+func validateMinItems(count int, fieldType reflect.StructField) error {
+	minTag := fieldType.Tag.Get("minItems")
+	if minTag == "" {
+		return nil
+	}
+
+	minItems, err := strconv.Atoi(minTag)
+	if err != nil {
+		return fmt.Errorf("invalid minItems tag: %v", err)
+	}
+	if count < minItems {
+		return fmt.Errorf("expected at least %d items, got %d", minItems, count)
+	}
+	return nil
+}
+
+// validateStringLength memvalidasi panjang string (dalam rune) terhadap tag minLen dan maxLen jika ada
+func validateStringLength(value string, fieldType reflect.StructField) error {
+	minTag, maxTag := fieldType.Tag.Get("minLen"), fieldType.Tag.Get("maxLen")
+	if minTag == "" && maxTag == "" {
+		return nil
+	}
+
+	length := utf8.RuneCountInString(value)
+
+	if minTag != "" {
+		minLen, err := strconv.Atoi(minTag)
+		if err != nil {
+			return fmt.Errorf("invalid minLen tag: %v", err)
+		}
+		if length < minLen {
+			return fmt.Errorf("length %d is less than minLen %d", length, minLen)
+		}
+	}
+
+	if maxTag != "" {
+		maxLen, err := strconv.Atoi(maxTag)
+		if err != nil {
+			return fmt.Errorf("invalid maxLen tag: %v", err)
+		}
+		if length > maxLen {
+			return fmt.Errorf("length %d is greater than maxLen %d", length, maxLen)
+		}
 	}
 
 	return nil
 }
 
+// countItems menghitung elemen slice, elemen string kosong tidak dihitung
+func countItems(field reflect.Value) int {
+	if field.Type().Elem().Kind() != reflect.String {
+		return field.Len()
+	}
+
+	count := 0
+	for i := 0; i < field.Len(); i++ {
+		if field.Index(i).String() != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// parseDurationFormat membaca durasi sesuai tag format, "iso8601" untuk format
+// seperti PT1H30M dan format Go (1h30m) untuk lainnya
+func parseDurationFormat(value, format string) (time.Duration, error) {
+	if format == "iso8601" {
+		return parseISODuration(value)
+	}
+	return time.ParseDuration(value)
+}
+
+// isoDurationUnits memetakan designator ISO-8601 ke durasinya, dipisah sebelum dan sesudah T
+var isoDurationUnits = map[bool]map[byte]time.Duration{
+	false: {'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour},
+	true:  {'H': time.Hour, 'M': time.Minute, 'S': time.Second},
+}
+
+// parseISODuration membaca durasi ISO-8601 seperti PT1H30M atau P1DT12H.
+// Tahun (Y) dan bulan (M sebelum T) tidak didukung karena panjangnya tidak tetap
+func parseISODuration(value string) (time.Duration, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+
+	sign := time.Duration(1)
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid ISO-8601 duration: %q", value)
+	}
+	s = s[1:]
+
+	var total time.Duration
+	inTime := false
+	seen := false
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return 0, fmt.Errorf("invalid ISO-8601 duration: %q", value)
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		i := 0
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.' || s[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return 0, fmt.Errorf("invalid ISO-8601 duration: %q", value)
+		}
+
+		unit, ok := isoDurationUnits[inTime][s[i]]
+		if !ok {
+			return 0, fmt.Errorf("unsupported ISO-8601 designator %q in %q", s[i], value)
+		}
+
+		number, err := strconv.ParseFloat(strings.Replace(s[:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO-8601 duration: %q", value)
+		}
+
+		total += time.Duration(number * float64(unit))
+		seen = true
+		s = s[i+1:]
+	}
+
+	if !seen {
+		return 0, fmt.Errorf("invalid ISO-8601 duration: %q", value)
+	}
+	return sign * total, nil
+}
+
+// byteUnits memetakan satuan ukuran ke jumlah byte
+var byteUnits = map[string]int64{
+	"":    1,
+	"B":   1,
+	"KB":  1000,
+	"MB":  1000 * 1000,
+	"GB":  1000 * 1000 * 1000,
+	"TB":  1000 * 1000 * 1000 * 1000,
+	"KIB": 1 << 10,
+	"MIB": 1 << 20,
+	"GIB": 1 << 30,
+	"TIB": 1 << 40,
+}
+
+// parseBytes mengubah ukuran seperti "10MB" atau "512KiB" menjadi jumlah byte
+func parseBytes(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	i := 0
+	for i < len(value) && (value[i] >= '0' && value[i] <= '9' || value[i] == '.') {
+		i++
+	}
+
+	number, unit := value[:i], strings.ToUpper(strings.TrimSpace(value[i:]))
+	multiplier, ok := byteUnits[unit]
+	if number == "" || !ok {
+		return 0, fmt.Errorf("invalid byte size: %q", value)
+	}
+
+	size, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size: %q", value)
+	}
+
+	// float64(math.MaxInt64) dibulatkan menjadi 2^63, sehingga batas atasnya eksklusif
+	total := size * float64(multiplier)
+	if total < 0 || total >= float64(math.MaxInt64) {
+		return 0, fmt.Errorf("byte size out of range: %q", value)
+	}
+	return int64(total), nil
+}
+
+// siUnits memetakan suffix SI desimal ke pengali
+var siUnits = map[string]int64{
+	"":  1,
+	"k": 1000,
+	"K": 1000,
+	"M": 1000 * 1000,
+	"G": 1000 * 1000 * 1000,
+}
+
+// parseSI mengubah jumlah seperti "10k", "2.5M", atau "1G" menjadi bilangan bulat dengan
+// pengali desimal (k = 1000). Berbeda dengan parseBytes, tidak ada satuan biner
+func parseSI(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return number, nil
+	}
+
+	i := 0
+	for i < len(value) && (value[i] >= '0' && value[i] <= '9' || value[i] == '.' || i == 0 && value[i] == '-') {
+		i++
+	}
+
+	number, unit := value[:i], strings.TrimSpace(value[i:])
+	multiplier, ok := siUnits[unit]
+	if number == "" || !ok {
+		return 0, fmt.Errorf("invalid SI number: %q", value)
+	}
+
+	parsed, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid SI number: %q", value)
+	}
+
+	total := parsed * float64(multiplier)
+	// float64(math.MaxInt64) dibulatkan menjadi 2^63, sehingga batas atasnya eksklusif
+	if total != math.Trunc(total) || total >= float64(math.MaxInt64) || total < math.MinInt64 {
+		return 0, fmt.Errorf("invalid SI number: %q", value)
+	}
+	return int64(total), nil
+}
+
 // Parse adalah fungsi level package yang mengisi struct dari environment variables
 func Parse(v interface{}) error {
 	cfg, err := getDefaultInstance()
@@ -146,3 +1047,81 @@ func Parse(v interface{}) error {
 	}
 	return cfg.Parse(v)
 }
+
+// ParseWith adalah fungsi level package yang mengisi struct menggunakan Config baru dari
+// New(options...), tanpa mengubah instance default. File .env dimuat seperti New, sehingga
+// opsi pemuatan file seperti WithMode, WithExtraFiles, dan WithProfiles ikut berlaku.
+// Contoh: env.ParseWith(&db, env.WithPrefix("DB_"))
+func ParseWith(v interface{}, options ...ConfigOption) error {
+	cfg, err := New(options...)
+	if err != nil {
+		return err
+	}
+	return cfg.Parse(v)
+}
+
+// ParseMode mengisi struct seolah-olah berjalan dalam mode tertentu tanpa mengubah Config
+// maupun environment proses. Nilai dibaca dari file .env milik mode tersebut (jika ada),
+// lalu dari environment, lalu dari tag default<Mode> dan default. Nilai dari file
+// diutamakan dibanding environment karena environment proses biasanya sudah berisi
+// nilai dari file mode yang sedang aktif
+func (c *Config) ParseMode(mode string, v interface{}) error {
+	preview := c.clone()
+	preview.Mode = mode
+
+	envFile, err := preview.envFile()
+	if err != nil {
+		return err
+	}
+
+	if fileExists(envFile) {
+		values, err := godotenv.Read(envFile)
+		if err != nil {
+			return err
+		}
+		preview.overrides = mergeOverrides(preview.overrides, values)
+	}
+
+	return preview.Parse(v)
+}
+
+// ParseDotted mengisi struct bertingkat dari key bertitik seperti DB.HOST dan DB.PORT,
+// misalnya hasil konversi file YAML. Field bertipe struct (tanpa tag decoder) dibaca
+// sebagai path: nama dari tag env (atau nama field dalam huruf besar) ditambah titik
+// menjadi awalan bagi field di dalamnya. Prefix Config ditambahkan di depan seluruh path,
+// jadi dengan prefix APP_ field DB.Host dibaca dari APP_DB.HOST
+func (c *Config) ParseDotted(v interface{}) error {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expect pointer to struct")
+	}
+
+	var fieldErrors []FieldError
+	if err := c.parseDotted(val.Elem(), &fieldErrors); err != nil {
+		return err
+	}
+
+	return finishParse(v, fieldErrors)
+}
+
+// parseDotted mengisi elem dan masuk ke field struct dengan prefix path yang diperpanjang
+func (c *Config) parseDotted(elem reflect.Value, fieldErrors *[]FieldError) error {
+	for _, fl := range structLayout(elem.Type()) {
+		field := elem.Field(fl.index)
+
+		if field.Kind() == reflect.Struct && fl.decoder == "" {
+			nested := c.clone()
+			nested.Prefix = c.Prefix + fl.envKey + "."
+			if err := nested.parseDotted(field, fieldErrors); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := c.collectFieldError(fieldErrors, c.parseField(field, fl)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,9 +1,12 @@
 package env
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -595,3 +598,1297 @@ func TestParseExhaustive(t *testing.T) {
 	// Restore original function
 	getDefaultInstance = origGetDefaultInstance
 }
+
+// TestParseDurationAndByteSlices tests parsing []time.Duration and []int64 fields
+func TestParseDurationAndByteSlices(t *testing.T) {
+	os.Setenv("PARSE_TIMEOUTS", "1s, 2m,500ms")
+	os.Setenv("PARSE_SIZES", "1MB, 2KiB,512")
+	os.Setenv("PARSE_COUNTS", "1,2,3")
+	defer func() {
+		os.Unsetenv("PARSE_TIMEOUTS")
+		os.Unsetenv("PARSE_SIZES")
+		os.Unsetenv("PARSE_COUNTS")
+	}()
+
+	type SliceConfig struct {
+		Timeouts []time.Duration `env:"PARSE_TIMEOUTS"`
+		Sizes    []int64         `env:"PARSE_SIZES" format:"bytes"`
+		Counts   []int64         `env:"PARSE_COUNTS"`
+	}
+
+	var config SliceConfig
+	if err := Parse(&config); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expectedTimeouts := []time.Duration{time.Second, 2 * time.Minute, 500 * time.Millisecond}
+	if !reflect.DeepEqual(config.Timeouts, expectedTimeouts) {
+		t.Errorf("Timeouts expected %v, got %v", expectedTimeouts, config.Timeouts)
+	}
+
+	expectedSizes := []int64{1000 * 1000, 2048, 512}
+	if !reflect.DeepEqual(config.Sizes, expectedSizes) {
+		t.Errorf("Sizes expected %v, got %v", expectedSizes, config.Sizes)
+	}
+
+	expectedCounts := []int64{1, 2, 3}
+	if !reflect.DeepEqual(config.Counts, expectedCounts) {
+		t.Errorf("Counts expected %v, got %v", expectedCounts, config.Counts)
+	}
+
+	// Mixed valid/invalid elements report the failing index
+	os.Setenv("PARSE_TIMEOUTS", "1s,oops,3s")
+	os.Setenv("PARSE_SIZES", "1MB,2XB")
+	var durConfig struct {
+		Timeouts []time.Duration `env:"PARSE_TIMEOUTS"`
+	}
+	err := Parse(&durConfig)
+	if err == nil || !strings.Contains(err.Error(), "index 1") {
+		t.Errorf("Parse with invalid duration element expected index error, got %v", err)
+	}
+
+	var sizeConfig struct {
+		Sizes []int64 `env:"PARSE_SIZES" format:"bytes"`
+	}
+	err = Parse(&sizeConfig)
+	if err == nil || !strings.Contains(err.Error(), "index 1") {
+		t.Errorf("Parse with invalid size element expected index error, got %v", err)
+	}
+
+	os.Setenv("PARSE_SIZES", "1MB,10000000TB")
+	err = Parse(&sizeConfig)
+	if err == nil || !strings.Contains(err.Error(), "index 1") {
+		t.Errorf("Parse with overflowing size element expected index error, got %v", err)
+	}
+}
+
+// TestParseBytes tests the byte size parser
+func TestParseBytes(t *testing.T) {
+	cases := map[string]int64{
+		"0":     0,
+		"512":   512,
+		"10B":   10,
+		"1KB":   1000,
+		"1.5MB": 1500000,
+		"1GB":   1000000000,
+		"1KiB":  1024,
+		"2MiB":  2 * 1024 * 1024,
+		"1 GiB": 1 << 30,
+		"1tib":  1 << 40,
+		" 3kb ": 3000,
+	}
+	for input, expected := range cases {
+		got, err := parseBytes(input)
+		if err != nil {
+			t.Errorf("parseBytes(%q) unexpected error: %v", input, err)
+			continue
+		}
+		if got != expected {
+			t.Errorf("parseBytes(%q) expected %d, got %d", input, expected, got)
+		}
+	}
+
+	for _, input := range []string{"", "MB", "1XB", "1.2.3MB", "-1MB"} {
+		if _, err := parseBytes(input); err == nil {
+			t.Errorf("parseBytes(%q) should fail", input)
+		}
+	}
+
+	// Sizes that do not fit in int64 must fail instead of wrapping around
+	for _, input := range []string{"10000000TB", "9223372036854775808", "8388608TiB"} {
+		if got, err := parseBytes(input); err == nil {
+			t.Errorf("parseBytes(%q) expected out of range error, got %d", input, got)
+		}
+	}
+}
+
+// TestParseFloatRangeTags tests min/max validation of float fields
+func TestParseFloatRangeTags(t *testing.T) {
+	os.Setenv("PARSE_RATE", "0.5")
+	defer os.Unsetenv("PARSE_RATE")
+
+	type RateConfig struct {
+		Rate float64 `env:"PARSE_RATE" min:"0" max:"1"`
+	}
+
+	var config RateConfig
+	if err := Parse(&config); err != nil || config.Rate != 0.5 {
+		t.Errorf("Parse in range expected 0.5, got %v (error: %v)", config.Rate, err)
+	}
+
+	for _, value := range []string{"-0.1", "1.1", "NaN", "Inf"} {
+		os.Setenv("PARSE_RATE", value)
+		if err := Parse(&RateConfig{}); err == nil {
+			t.Errorf("Parse with out of range value '%s' should fail", value)
+		}
+	}
+
+	// Fields without range tags keep accepting any float
+	os.Setenv("PARSE_RATE", "NaN")
+	var unbounded struct {
+		Rate float32 `env:"PARSE_RATE"`
+	}
+	if err := Parse(&unbounded); err != nil {
+		t.Errorf("Parse without range tags should accept NaN, got %v", err)
+	}
+
+	// Invalid tag values
+	os.Setenv("PARSE_RATE", "0.5")
+	var badMin struct {
+		Rate float64 `env:"PARSE_RATE" min:"low"`
+	}
+	if err := Parse(&badMin); err == nil {
+		t.Error("Parse with invalid min tag should fail")
+	}
+	var badMax struct {
+		Rate float64 `env:"PARSE_RATE" max:"high"`
+	}
+	if err := Parse(&badMax); err == nil {
+		t.Error("Parse with invalid max tag should fail")
+	}
+}
+
+// benchPrimitiveConfig is a 20-field primitive-only struct used by the Parse benchmarks
+type benchPrimitiveConfig struct {
+	S1 string  `env:"BENCH_S1"`
+	S2 string  `env:"BENCH_S2"`
+	S3 string  `env:"BENCH_S3"`
+	S4 string  `env:"BENCH_S4" default:"four"`
+	S5 string  `env:"BENCH_S5" default:"five"`
+	I1 int     `env:"BENCH_I1"`
+	I2 int     `env:"BENCH_I2"`
+	I3 int8    `env:"BENCH_I3"`
+	I4 int16   `env:"BENCH_I4"`
+	I5 int64   `env:"BENCH_I5" default:"5"`
+	U1 uint    `env:"BENCH_U1"`
+	U2 uint8   `env:"BENCH_U2"`
+	U3 uint32  `env:"BENCH_U3"`
+	U4 uint64  `env:"BENCH_U4" default:"4"`
+	F1 float32 `env:"BENCH_F1"`
+	F2 float64 `env:"BENCH_F2"`
+	F3 float64 `env:"BENCH_F3" default:"3.3"`
+	B1 bool    `env:"BENCH_B1"`
+	B2 bool    `env:"BENCH_B2"`
+	B3 bool    `env:"BENCH_B3" default:"yes"`
+}
+
+// setBenchEnv sets environment variables for benchPrimitiveConfig
+func setBenchEnv(tb testing.TB) func() {
+	tb.Helper()
+	envVars := map[string]string{
+		"BENCH_S1": "one", "BENCH_S2": "two", "BENCH_S3": "three",
+		"BENCH_I1": "1", "BENCH_I2": "2", "BENCH_I3": "3", "BENCH_I4": "4",
+		"BENCH_U1": "1", "BENCH_U2": "2", "BENCH_U3": "3",
+		"BENCH_F1": "1.1", "BENCH_F2": "2.2",
+		"BENCH_B1": "true", "BENCH_B2": "0",
+	}
+	for k, v := range envVars {
+		os.Setenv(k, v)
+	}
+	return func() {
+		for k := range envVars {
+			os.Unsetenv(k)
+		}
+	}
+}
+
+// TestParseLayoutCache tests the cached struct layout used by Parse
+func TestParseLayoutCache(t *testing.T) {
+	defer setBenchEnv(t)()
+
+	typ := reflect.TypeOf(benchPrimitiveConfig{})
+	layoutCache.Delete(typ)
+
+	cfg := &Config{}
+	var config benchPrimitiveConfig
+	if err := cfg.Parse(&config); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := benchPrimitiveConfig{
+		S1: "one", S2: "two", S3: "three", S4: "four", S5: "five",
+		I1: 1, I2: 2, I3: 3, I4: 4, I5: 5,
+		U1: 1, U2: 2, U3: 3, U4: 4,
+		F1: 1.1, F2: 2.2, F3: 3.3,
+		B1: true, B2: false, B3: true,
+	}
+	if config != expected {
+		t.Errorf("Parse expected %+v, got %+v", expected, config)
+	}
+
+	cached, ok := layoutCache.Load(typ)
+	if !ok {
+		t.Fatal("Parse should cache the struct layout")
+	}
+	layout := cached.([]fieldLayout)
+	if len(layout) != 20 {
+		t.Errorf("Expected 20 fields in layout, got %d", len(layout))
+	}
+	for _, fl := range layout {
+		if !fl.primitive {
+			t.Errorf("Field %s expected to be primitive", fl.field.Name)
+		}
+	}
+
+	// Non-primitive fields take the generic path
+	type mixedConfig struct {
+		Timeout time.Duration `env:"PARSE_MIXED_TIMEOUT"`
+		Rate    float64       `env:"PARSE_MIXED_RATE" max:"1"`
+		Hosts   []string      `env:"PARSE_MIXED_HOSTS"`
+		Name    string        `env:"PARSE_MIXED_NAME"`
+	}
+	mixed := structLayout(reflect.TypeOf(mixedConfig{}))
+	if len(mixed) != 4 {
+		t.Fatalf("Expected 4 fields in layout, got %d", len(mixed))
+	}
+	for _, fl := range mixed {
+		if fl.primitive != (fl.field.Name == "Name") {
+			t.Errorf("Field %s primitive=%v is unexpected", fl.field.Name, fl.primitive)
+		}
+	}
+}
+
+// BenchmarkParsePrimitiveStruct measures Parse with a cached layout (fast path)
+func BenchmarkParsePrimitiveStruct(b *testing.B) {
+	defer setBenchEnv(b)()
+
+	cfg := &Config{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var config benchPrimitiveConfig
+		if err := cfg.Parse(&config); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkParsePrimitiveStructUncached measures Parse when the layout is rebuilt
+// every time, approximating the behavior before the layout cache
+func BenchmarkParsePrimitiveStructUncached(b *testing.B) {
+	defer setBenchEnv(b)()
+
+	cfg := &Config{}
+	typ := reflect.TypeOf(benchPrimitiveConfig{})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		layoutCache.Delete(typ)
+		var config benchPrimitiveConfig
+		if err := cfg.Parse(&config); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestParseWith tests parsing with options without touching the singleton
+func TestParseWith(t *testing.T) {
+	os.Setenv("DB_HOST", "db.local")
+	os.Setenv("CACHE_HOST", "cache.local")
+	defer func() {
+		os.Unsetenv("DB_HOST")
+		os.Unsetenv("CACHE_HOST")
+	}()
+
+	origGetDefaultInstance := getDefaultInstance
+	defer func() { getDefaultInstance = origGetDefaultInstance }()
+
+	singleton := &Config{Mode: Development}
+	getDefaultInstance = func() (*Config, error) {
+		return singleton, nil
+	}
+
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	os.Chdir(t.TempDir())
+
+	type HostConfig struct {
+		Host string `env:"HOST"`
+	}
+
+	var db, cache HostConfig
+	if err := ParseWith(&db, WithPrefix("DB_")); err != nil {
+		t.Fatalf("ParseWith(DB_) failed: %v", err)
+	}
+	if err := ParseWith(&cache, WithPrefix("CACHE_")); err != nil {
+		t.Fatalf("ParseWith(CACHE_) failed: %v", err)
+	}
+
+	if db.Host != "db.local" || cache.Host != "cache.local" {
+		t.Errorf("ParseWith expected db.local and cache.local, got %s and %s", db.Host, cache.Host)
+	}
+	if singleton.Prefix != "" {
+		t.Errorf("ParseWith should not modify the singleton, got prefix '%s'", singleton.Prefix)
+	}
+
+	// Invalid target
+	if err := ParseWith(db, WithPrefix("DB_")); err == nil {
+		t.Error("ParseWith with non-pointer should fail")
+	}
+
+	// File loading options behave like New, independent of the singleton
+	getDefaultInstance = func() (*Config, error) {
+		return nil, fmt.Errorf("mock error")
+	}
+	os.WriteFile(".env.staging", []byte("STAGED_HOST=staging.local\n"), 0644)
+	defer os.Unsetenv("STAGED_HOST")
+
+	var staged HostConfig
+	if err := ParseWith(&staged, WithMode(Staging), WithPrefix("STAGED_")); err != nil || staged.Host != "staging.local" {
+		t.Errorf("ParseWith(WithMode(staging)) expected staging.local, got '%s' (error: %v)", staged.Host, err)
+	}
+	if singleton.Mode != Development {
+		t.Errorf("ParseWith should not modify the singleton, got mode '%s'", singleton.Mode)
+	}
+	if err := ParseWith(&staged, WithMode("invalid_mode")); err == nil {
+		t.Error("ParseWith with invalid mode should fail")
+	}
+}
+
+// TestParseRequiredOptionalStrict tests required/optional tags and strict mode
+func TestParseRequiredOptionalStrict(t *testing.T) {
+	os.Setenv("PARSE_REQ_NAME", "app")
+	defer os.Unsetenv("PARSE_REQ_NAME")
+
+	type RequiredConfig struct {
+		Name  string `env:"PARSE_REQ_NAME" required:"true"`
+		Token string `env:"PARSE_REQ_TOKEN" required:"true"`
+	}
+
+	// required:"true" errors without strict mode
+	cfg := &Config{}
+	err := cfg.Parse(&RequiredConfig{})
+	if err == nil || !strings.Contains(err.Error(), "PARSE_REQ_TOKEN") {
+		t.Errorf("Parse with missing required field expected error, got %v", err)
+	}
+
+	type MixedConfig struct {
+		Name     string `env:"PARSE_REQ_NAME"`
+		Port     int    `env:"PARSE_REQ_PORT" default:"8080"`
+		Optional string `env:"PARSE_REQ_OPTIONAL" optional:"true"`
+		NotReq   string `env:"PARSE_REQ_NOT_REQUIRED" required:"false"`
+		Both     string `env:"PARSE_REQ_BOTH" required:"true" optional:"true"`
+	}
+
+	// Strict mode: missing fields with default or optional tags pass
+	strict := cfg.From(WithStrictParse())
+	var mixed MixedConfig
+	if err := strict.Parse(&mixed); err != nil {
+		t.Errorf("Strict Parse with optional fields expected no error, got %v", err)
+	}
+	if mixed.Name != "app" || mixed.Port != 8080 || mixed.Optional != "" {
+		t.Errorf("Strict Parse unexpected result %+v", mixed)
+	}
+
+	// Strict mode: untagged missing field errors
+	var strictMissing struct {
+		Name    string `env:"PARSE_REQ_NAME"`
+		Missing string `env:"PARSE_REQ_MISSING"`
+	}
+	err = strict.Parse(&strictMissing)
+	if err == nil || !strings.Contains(err.Error(), "Missing") {
+		t.Errorf("Strict Parse with missing field expected error, got %v", err)
+	}
+
+	// Non-strict mode leaves missing fields as zero values
+	if err := cfg.Parse(&strictMissing); err != nil {
+		t.Errorf("Non-strict Parse expected no error, got %v", err)
+	}
+}
+
+// TestParseModeDefaults tests default<Mode> tags
+func TestParseModeDefaults(t *testing.T) {
+	type ModeConfig struct {
+		LogLevel string `env:"PARSE_MODE_LOG_LEVEL" default:"info" defaultDevelopment:"debug" defaultProduction:"warn"`
+	}
+
+	cases := map[string]string{
+		Production:  "warn",
+		Staging:     "info",
+		Development: "debug",
+	}
+	for mode, expected := range cases {
+		var config ModeConfig
+		if err := (&Config{Mode: mode}).Parse(&config); err != nil {
+			t.Fatalf("Parse in %s failed: %v", mode, err)
+		}
+		if config.LogLevel != expected {
+			t.Errorf("Parse in %s expected '%s', got '%s'", mode, expected, config.LogLevel)
+		}
+	}
+
+	// Environment wins over mode defaults
+	os.Setenv("PARSE_MODE_LOG_LEVEL", "error")
+	defer os.Unsetenv("PARSE_MODE_LOG_LEVEL")
+	var config ModeConfig
+	if err := (&Config{Mode: Production}).Parse(&config); err != nil || config.LogLevel != "error" {
+		t.Errorf("Parse with env expected 'error', got '%s' (error: %v)", config.LogLevel, err)
+	}
+}
+
+// TestParseMode tests previewing another mode without changing state
+func TestParseMode(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current directory: %v", err)
+	}
+	defer func() {
+		if err := os.Chdir(oldDir); err != nil {
+			t.Errorf("Failed to restore original directory: %v", err)
+		}
+	}()
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change to temp directory: %v", err)
+	}
+
+	if err := os.WriteFile(".env", []byte("PREVIEW_HOST=prod.example.com"), 0644); err != nil {
+		t.Fatalf("Failed to create .env file: %v", err)
+	}
+
+	// The current process has development values loaded
+	os.Setenv("PREVIEW_HOST", "localhost")
+	defer os.Unsetenv("PREVIEW_HOST")
+
+	type PreviewConfig struct {
+		Host     string `env:"PREVIEW_HOST"`
+		LogLevel string `env:"PREVIEW_LOG_LEVEL" default:"info" defaultProduction:"warn"`
+	}
+
+	cfg := &Config{Mode: Development}
+	var preview PreviewConfig
+	if err := cfg.ParseMode(Production, &preview); err != nil {
+		t.Fatalf("ParseMode failed: %v", err)
+	}
+	if preview.Host != "prod.example.com" || preview.LogLevel != "warn" {
+		t.Errorf("ParseMode(production) expected {prod.example.com warn}, got %+v", preview)
+	}
+
+	// State is untouched
+	if cfg.Mode != Development || os.Getenv("PREVIEW_HOST") != "localhost" {
+		t.Error("ParseMode should not change the config mode or the environment")
+	}
+	var current PreviewConfig
+	if err := cfg.Parse(&current); err != nil || current.Host != "localhost" || current.LogLevel != "info" {
+		t.Errorf("Parse after ParseMode expected {localhost info}, got %+v (error: %v)", current, err)
+	}
+
+	// Mode without a file falls back to environment and defaults
+	var staging PreviewConfig
+	if err := cfg.ParseMode(Staging, &staging); err != nil || staging.Host != "localhost" || staging.LogLevel != "info" {
+		t.Errorf("ParseMode(staging) expected {localhost info}, got %+v (error: %v)", staging, err)
+	}
+
+	// Invalid mode
+	if err := cfg.ParseMode("invalid_mode", &staging); err == nil {
+		t.Error("ParseMode with invalid mode should fail")
+	}
+
+	// Unreadable file
+	if err := os.Mkdir(".env.staging", 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := cfg.ParseMode(Staging, &staging); err == nil {
+		t.Error("ParseMode with unreadable file should fail")
+	}
+}
+
+// TestParseISODuration tests the ISO-8601 duration parser
+func TestParseISODuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"PT1H30M":    90 * time.Minute,
+		"PT45S":      45 * time.Second,
+		"PT0.5S":     500 * time.Millisecond,
+		"PT1,5S":     1500 * time.Millisecond,
+		"P1D":        24 * time.Hour,
+		"P1DT12H":    36 * time.Hour,
+		"P2W":        14 * 24 * time.Hour,
+		"pt10m":      10 * time.Minute,
+		"-PT5M":      -5 * time.Minute,
+		"+PT5M":      5 * time.Minute,
+		" PT1H2M3S ": time.Hour + 2*time.Minute + 3*time.Second,
+	}
+	for input, expected := range cases {
+		got, err := parseISODuration(input)
+		if err != nil {
+			t.Errorf("parseISODuration(%q) unexpected error: %v", input, err)
+			continue
+		}
+		if got != expected {
+			t.Errorf("parseISODuration(%q) expected %v, got %v", input, expected, got)
+		}
+	}
+
+	invalid := []string{"", "P", "PT", "1H", "P1Y", "P1M", "PT1X", "P1DT", "PTH", "PT1H1", "P1H", "PT1HT1M"}
+	for _, input := range invalid {
+		if _, err := parseISODuration(input); err == nil {
+			t.Errorf("parseISODuration(%q) should fail", input)
+		}
+	}
+}
+
+// TestParseISODurationTag tests the format:"iso8601" struct tag
+func TestParseISODurationTag(t *testing.T) {
+	os.Setenv("PARSE_ISO_TIMEOUT", "PT1M30S")
+	os.Setenv("PARSE_ISO_RETRIES", "PT1S,PT2S")
+	defer func() {
+		os.Unsetenv("PARSE_ISO_TIMEOUT")
+		os.Unsetenv("PARSE_ISO_RETRIES")
+	}()
+
+	var config struct {
+		Timeout time.Duration   `env:"PARSE_ISO_TIMEOUT" format:"iso8601"`
+		Retries []time.Duration `env:"PARSE_ISO_RETRIES" format:"iso8601"`
+		Default time.Duration   `env:"PARSE_ISO_MISSING" format:"iso8601" default:"P1D"`
+	}
+	if err := Parse(&config); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if config.Timeout != 90*time.Second {
+		t.Errorf("Timeout expected 1m30s, got %v", config.Timeout)
+	}
+	if !reflect.DeepEqual(config.Retries, []time.Duration{time.Second, 2 * time.Second}) {
+		t.Errorf("Retries expected [1s 2s], got %v", config.Retries)
+	}
+	if config.Default != 24*time.Hour {
+		t.Errorf("Default expected 24h, got %v", config.Default)
+	}
+
+	// Go-style durations are rejected when the format is iso8601
+	os.Setenv("PARSE_ISO_TIMEOUT", "90s")
+	if err := Parse(&config); err == nil {
+		t.Error("Parse with Go duration and iso8601 format should fail")
+	}
+}
+
+// TestParseStructPrefix tests the struct-level prefix declared on a blank field
+func TestParseStructPrefix(t *testing.T) {
+	os.Setenv("APP_SERVICE_HOST", "svc.local")
+	os.Setenv("APP_SERVICE_PORT", "7000")
+	os.Setenv("APP_HOST", "wrong")
+	defer os.Unsetenv("APP_SERVICE_HOST")
+	defer os.Unsetenv("APP_SERVICE_PORT")
+	defer os.Unsetenv("APP_HOST")
+
+	type serviceConfig struct {
+		_       struct{} `envPrefix:"SERVICE_"`
+		Host    string   `env:"HOST"`
+		Port    int      `env:"PORT"`
+		Timeout string   `env:"TIMEOUT" default:"30s"`
+	}
+
+	var cfg serviceConfig
+	if err := (&Config{Prefix: "APP_"}).Parse(&cfg); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if cfg.Host != "svc.local" || cfg.Port != 7000 || cfg.Timeout != "30s" {
+		t.Errorf("Parse with struct prefix expected {svc.local 7000 30s}, got %+v", cfg)
+	}
+
+	// Without a Config prefix, only the struct prefix applies
+	os.Setenv("SERVICE_HOST", "bare.local")
+	defer os.Unsetenv("SERVICE_HOST")
+	var bare serviceConfig
+	if err := (&Config{}).Parse(&bare); err != nil || bare.Host != "bare.local" {
+		t.Errorf("Parse without Config prefix expected Host 'bare.local', got '%s' (error: %v)", bare.Host, err)
+	}
+}
+
+// TestParseMinItems tests the minItems tag on slice fields
+func TestParseMinItems(t *testing.T) {
+	type hostsConfig struct {
+		Hosts []string `env:"MINITEMS_HOSTS" minItems:"1"`
+		Ports []int64  `env:"MINITEMS_PORTS" minItems:"2"`
+	}
+
+	os.Setenv("MINITEMS_HOSTS", "a.com,b.com")
+	os.Setenv("MINITEMS_PORTS", "80,443")
+	defer os.Unsetenv("MINITEMS_HOSTS")
+	defer os.Unsetenv("MINITEMS_PORTS")
+
+	var cfg hostsConfig
+	if err := (&Config{}).Parse(&cfg); err != nil {
+		t.Errorf("Parse with enough items failed: %v", err)
+	}
+
+	os.Setenv("MINITEMS_HOSTS", "")
+	if err := (&Config{}).Parse(&hostsConfig{}); err == nil || !strings.Contains(err.Error(), "MINITEMS_HOSTS") {
+		t.Errorf("Parse with empty value expected minItems error, got %v", err)
+	}
+
+	os.Setenv("MINITEMS_HOSTS", " , ")
+	if err := (&Config{}).Parse(&hostsConfig{}); err == nil {
+		t.Error("Parse with only empty elements should fail")
+	}
+
+	os.Setenv("MINITEMS_HOSTS", "a.com")
+	os.Setenv("MINITEMS_PORTS", "80")
+	if err := (&Config{}).Parse(&hostsConfig{}); err == nil || !strings.Contains(err.Error(), "at least 2") {
+		t.Errorf("Parse with too few ports expected minItems error, got %v", err)
+	}
+}
+
+// finalizeConfig derives Addr from Host and Port after parsing
+type finalizeConfig struct {
+	Host string `env:"FINALIZE_HOST" default:"localhost"`
+	Port int    `env:"FINALIZE_PORT" default:"8080"`
+	Addr string `env:"FINALIZE_ADDR_UNUSED"`
+}
+
+// Finalize implements Finalizer
+func (f *finalizeConfig) Finalize() error {
+	if f.Port == 0 {
+		return fmt.Errorf("port must not be zero")
+	}
+	f.Addr = fmt.Sprintf("%s:%d", f.Host, f.Port)
+	return nil
+}
+
+// TestParseFinalizer tests the post-parse Finalize hook
+func TestParseFinalizer(t *testing.T) {
+	var cfg finalizeConfig
+	if err := (&Config{}).Parse(&cfg); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if cfg.Addr != "localhost:8080" {
+		t.Errorf("Finalize expected Addr 'localhost:8080', got '%s'", cfg.Addr)
+	}
+
+	os.Setenv("FINALIZE_PORT", "0")
+	defer os.Unsetenv("FINALIZE_PORT")
+	if err := (&Config{}).Parse(&finalizeConfig{}); err == nil || err.Error() != "port must not be zero" {
+		t.Errorf("Parse expected finalize error, got %v", err)
+	}
+
+	// Finalize is not called when a field fails to parse
+	os.Setenv("FINALIZE_PORT", "abc")
+	invalid := finalizeConfig{}
+	if err := (&Config{}).Parse(&invalid); err == nil || invalid.Addr != "" {
+		t.Errorf("Parse with invalid field expected error without Finalize, got %v (Addr '%s')", err, invalid.Addr)
+	}
+}
+
+// TestParseGlobTag tests the format:"glob" tag on []string fields
+func TestParseGlobTag(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"one.so", "two.so"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0600); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	os.Setenv("GLOB_PLUGINS", filepath.Join(dir, "*.so"))
+	defer os.Unsetenv("GLOB_PLUGINS")
+
+	var cfg struct {
+		Plugins []string `env:"GLOB_PLUGINS" format:"glob"`
+	}
+	if err := (&Config{}).Parse(&cfg); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	expected := []string{filepath.Join(dir, "one.so"), filepath.Join(dir, "two.so")}
+	if !reflect.DeepEqual(cfg.Plugins, expected) {
+		t.Errorf("Parse expected %v, got %v", expected, cfg.Plugins)
+	}
+
+	os.Setenv("GLOB_PLUGINS", "[")
+	if err := (&Config{}).Parse(&cfg); err == nil {
+		t.Error("Parse with bad glob pattern should fail")
+	}
+}
+
+// TestParseDotted tests nested struct population from dotted keys
+func TestParseDotted(t *testing.T) {
+	values := map[string]string{
+		"DOTTED_NAME":              "app",
+		"DOTTED_DB.HOST":           "db.local",
+		"DOTTED_DB.PORT":           "5432",
+		"DOTTED_DB.POOL.MAX_CONNS": "20",
+		"DOTTED_CACHE.TTL":         "30s",
+	}
+	for k, v := range values {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+
+	type poolConfig struct {
+		MaxConns int `env:"MAX_CONNS"`
+		MinConns int `env:"MIN_CONNS" default:"2"`
+	}
+	type dottedConfig struct {
+		Name     string
+		Database struct {
+			Host string
+			Port int
+			Pool poolConfig
+		} `env:"DB"`
+		Cache struct {
+			TTL time.Duration
+		}
+	}
+
+	var cfg dottedConfig
+	if err := (&Config{Prefix: "DOTTED_"}).ParseDotted(&cfg); err != nil {
+		t.Fatalf("ParseDotted failed: %v", err)
+	}
+
+	if cfg.Name != "app" || cfg.Database.Host != "db.local" || cfg.Database.Port != 5432 {
+		t.Errorf("ParseDotted expected first level values, got %+v", cfg)
+	}
+	if cfg.Database.Pool.MaxConns != 20 || cfg.Database.Pool.MinConns != 2 {
+		t.Errorf("ParseDotted expected pool {20 2}, got %+v", cfg.Database.Pool)
+	}
+	if cfg.Cache.TTL != 30*time.Second {
+		t.Errorf("ParseDotted expected Cache.TTL 30s, got %v", cfg.Cache.TTL)
+	}
+
+	os.Setenv("DOTTED_DB.POOL.MAX_CONNS", "many")
+	if err := (&Config{Prefix: "DOTTED_"}).ParseDotted(&dottedConfig{}); err == nil || !strings.Contains(err.Error(), "MaxConns") {
+		t.Errorf("ParseDotted with invalid nested value expected error, got %v", err)
+	}
+
+	if err := (&Config{}).ParseDotted(cfg); err == nil {
+		t.Error("ParseDotted with non-pointer should fail")
+	}
+}
+
+// TestNumericWhitespaceConsistency tests that scalar numbers are trimmed in every API
+func TestNumericWhitespaceConsistency(t *testing.T) {
+	os.Setenv("WS_PORT", " 42 ")
+	os.Setenv("WS_RATIO", "\t0.5 ")
+	os.Setenv("WS_SIZE", " 7")
+	defer os.Unsetenv("WS_PORT")
+	defer os.Unsetenv("WS_RATIO")
+	defer os.Unsetenv("WS_SIZE")
+
+	cfg := &Config{Prefix: "WS_"}
+
+	var parsed struct {
+		Port  int     `env:"PORT"`
+		Ratio float64 `env:"RATIO" max:"1"`
+		Size  uint16  `env:"SIZE"`
+	}
+	if err := cfg.Parse(&parsed); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if parsed.Port != 42 || parsed.Ratio != 0.5 || parsed.Size != 7 {
+		t.Errorf("Parse expected {42 0.5 7}, got %+v", parsed)
+	}
+
+	if val, err := cfg.GetInt("PORT"); err != nil || val != 42 {
+		t.Errorf("GetInt() expected 42, got %d (error: %v)", val, err)
+	}
+	if val, err := cfg.GetFloat64("RATIO"); err != nil || val != 0.5 {
+		t.Errorf("GetFloat64() expected 0.5, got %v (error: %v)", val, err)
+	}
+	if val, err := cfg.Key("PORT").Int(); err != nil || val != 42 {
+		t.Errorf("Key().Int() expected 42, got %d (error: %v)", val, err)
+	}
+	if val, err := cfg.Key("RATIO").Float64(); err != nil || val != 0.5 {
+		t.Errorf("Key().Float64() expected 0.5, got %v (error: %v)", val, err)
+	}
+	if val, err := As[uint16](cfg.Key("SIZE")); err != nil || val != 7 {
+		t.Errorf("As[uint16]() expected 7, got %d (error: %v)", val, err)
+	}
+}
+
+// TestParseBoolPtr tests *bool fields in Parse
+func TestParseBoolPtr(t *testing.T) {
+	os.Setenv("BOOLPTR_ON", "true")
+	os.Setenv("BOOLPTR_OFF", "0")
+	defer os.Unsetenv("BOOLPTR_ON")
+	defer os.Unsetenv("BOOLPTR_OFF")
+
+	var cfg struct {
+		On    *bool `env:"BOOLPTR_ON"`
+		Off   *bool `env:"BOOLPTR_OFF"`
+		Unset *bool `env:"BOOLPTR_UNSET"`
+	}
+	if err := (&Config{}).Parse(&cfg); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if cfg.On == nil || !*cfg.On || cfg.Off == nil || *cfg.Off || cfg.Unset != nil {
+		t.Errorf("Parse expected {true false nil}, got {%v %v %v}", cfg.On, cfg.Off, cfg.Unset)
+	}
+
+	os.Setenv("BOOLPTR_ON", "maybe")
+	var invalid struct {
+		On *bool `env:"BOOLPTR_ON"`
+	}
+	if err := (&Config{}).Parse(&invalid); err == nil || invalid.On != nil {
+		t.Errorf("Parse with invalid bool expected error and nil, got %v (error: %v)", invalid.On, err)
+	}
+}
+
+// TestParseCollectTag tests map fields populated from individual variables
+func TestParseCollectTag(t *testing.T) {
+	os.Setenv("APP_LABEL_TEAM", "core")
+	os.Setenv("APP_LABEL_QUERY", "a:b,c=d")
+	defer os.Unsetenv("APP_LABEL_TEAM")
+	defer os.Unsetenv("APP_LABEL_QUERY")
+
+	var cfg struct {
+		Labels map[string]string `collect:"LABEL_"`
+		Tags   map[string]string `collect:"TAG_"`
+	}
+	if err := (&Config{Prefix: "APP_"}).Parse(&cfg); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	expected := map[string]string{"TEAM": "core", "QUERY": "a:b,c=d"}
+	if !reflect.DeepEqual(cfg.Labels, expected) {
+		t.Errorf("Parse expected Labels %v, got %v", expected, cfg.Labels)
+	}
+	if cfg.Tags != nil {
+		t.Errorf("Parse without matches expected nil Tags, got %v", cfg.Tags)
+	}
+
+	var required struct {
+		Tags map[string]string `collect:"TAG_" required:"true"`
+	}
+	if err := (&Config{Prefix: "APP_"}).Parse(&required); err == nil {
+		t.Error("Parse with required collect field and no matches should fail")
+	}
+
+	var wrongType struct {
+		Labels []string `collect:"LABEL_"`
+	}
+	if err := (&Config{Prefix: "APP_"}).Parse(&wrongType); err == nil {
+		t.Error("Parse with collect tag on non-map field should fail")
+	}
+}
+
+// TestParseStringLength tests the minLen and maxLen tags on string fields
+func TestParseStringLength(t *testing.T) {
+	type nameConfig struct {
+		Name string `env:"STRLEN_NAME" minLen:"2" maxLen:"4"`
+	}
+	defer os.Unsetenv("STRLEN_NAME")
+
+	os.Setenv("STRLEN_NAME", "日本語テ")
+	var cfg nameConfig
+	if err := (&Config{}).Parse(&cfg); err != nil || cfg.Name != "日本語テ" {
+		t.Errorf("Parse with 4 runes failed: %v (name %q)", err, cfg.Name)
+	}
+
+	os.Setenv("STRLEN_NAME", "abcde")
+	if err := (&Config{}).Parse(&nameConfig{}); err == nil || !strings.Contains(err.Error(), "maxLen 4") {
+		t.Errorf("Parse with too long value expected maxLen error, got %v", err)
+	}
+
+	os.Setenv("STRLEN_NAME", "a")
+	if err := (&Config{}).Parse(&nameConfig{}); err == nil || !strings.Contains(err.Error(), "Name") {
+		t.Errorf("Parse with too short value expected minLen error naming the field, got %v", err)
+	}
+}
+
+// TestParseToMap tests ParseToMap returning effective values with secrets redacted
+func TestParseToMap(t *testing.T) {
+	type mapConfig struct {
+		Host     string        `env:"HOST" default:"localhost"`
+		Port     int           `env:"PORT"`
+		Tags     []string      `env:"TAGS"`
+		Timeout  time.Duration `env:"TIMEOUT"`
+		Password string        `env:"PASSWORD" secret:"true"`
+		Token    string        `env:"TOKEN"`
+		Unset    string        `env:"UNSET"`
+	}
+
+	os.Setenv("PTM_PORT", "8080")
+	os.Setenv("PTM_TAGS", "a, b")
+	os.Setenv("PTM_TIMEOUT", "5s")
+	os.Setenv("PTM_PASSWORD", "hunter2")
+	os.Setenv("PTM_TOKEN", "abc")
+	defer os.Unsetenv("PTM_PORT")
+	defer os.Unsetenv("PTM_TAGS")
+	defer os.Unsetenv("PTM_TIMEOUT")
+	defer os.Unsetenv("PTM_PASSWORD")
+	defer os.Unsetenv("PTM_TOKEN")
+
+	cfg := &Config{Prefix: "PTM_"}
+	WithSecrets("TOKEN")(cfg)
+
+	var mc mapConfig
+	values, err := cfg.ParseToMap(&mc)
+	if err != nil {
+		t.Fatalf("ParseToMap failed: %v", err)
+	}
+
+	expected := map[string]string{
+		"PTM_HOST":     "localhost",
+		"PTM_PORT":     "8080",
+		"PTM_TAGS":     "a,b",
+		"PTM_TIMEOUT":  "5s",
+		"PTM_PASSWORD": RedactedValue,
+		"PTM_TOKEN":    RedactedValue,
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("ParseToMap expected %v, got %v", expected, values)
+	}
+	if mc.Password != "hunter2" {
+		t.Errorf("ParseToMap should still fill secret fields, got %q", mc.Password)
+	}
+
+	os.Setenv("PTM_PORT", "abc")
+	if _, err := cfg.ParseToMap(&mapConfig{}); err == nil {
+		t.Error("ParseToMap with invalid value expected error")
+	}
+}
+
+// TestParseDurationRange tests min and max tags on time.Duration fields
+func TestParseDurationRange(t *testing.T) {
+	type healthConfig struct {
+		Timeout  time.Duration `env:"DURRANGE_TIMEOUT" min:"1s" max:"30s"`
+		Interval time.Duration `env:"DURRANGE_INTERVAL" min:"5s"`
+	}
+	defer os.Unsetenv("DURRANGE_TIMEOUT")
+	defer os.Unsetenv("DURRANGE_INTERVAL")
+
+	os.Setenv("DURRANGE_TIMEOUT", "10s")
+	os.Setenv("DURRANGE_INTERVAL", "1m")
+	var cfg healthConfig
+	if err := (&Config{}).Parse(&cfg); err != nil || cfg.Timeout != 10*time.Second {
+		t.Errorf("Parse within range failed: %v (timeout %v)", err, cfg.Timeout)
+	}
+
+	os.Setenv("DURRANGE_TIMEOUT", "45s")
+	if err := (&Config{}).Parse(&healthConfig{}); err == nil || !strings.Contains(err.Error(), "Timeout") || !strings.Contains(err.Error(), "max 30s") {
+		t.Errorf("Parse above max expected error naming the field, got %v", err)
+	}
+
+	os.Setenv("DURRANGE_INTERVAL", "1s")
+	err := With(WithErrorAggregation()).Parse(&healthConfig{})
+	var perr *ParseError
+	if !errors.As(err, &perr) || len(perr.Fields) != 2 {
+		t.Errorf("Parse with aggregation expected 2 field errors, got %v", err)
+	}
+}
+
+// TestParseSkipUnsupported tests WithSkipUnsupported on a struct mixing supported and unsupported fields
+func TestParseSkipUnsupported(t *testing.T) {
+	type mixedConfig struct {
+		Name    string         `env:"SKIPUNS_NAME"`
+		Events  chan string    `env:"SKIPUNS_EVENTS"`
+		Handler func()         `env:"SKIPUNS_HANDLER"`
+		Weights []float64      `env:"SKIPUNS_WEIGHTS"`
+		Limits  map[string]int `env:"SKIPUNS_LIMITS"`
+		Port    int            `env:"SKIPUNS_PORT"`
+	}
+
+	os.Setenv("SKIPUNS_NAME", "api")
+	os.Setenv("SKIPUNS_EVENTS", "x")
+	os.Setenv("SKIPUNS_HANDLER", "x")
+	os.Setenv("SKIPUNS_WEIGHTS", "0.5,1.5")
+	os.Setenv("SKIPUNS_LIMITS", "a:1")
+	os.Setenv("SKIPUNS_PORT", "8080")
+	defer os.Unsetenv("SKIPUNS_NAME")
+	defer os.Unsetenv("SKIPUNS_EVENTS")
+	defer os.Unsetenv("SKIPUNS_HANDLER")
+	defer os.Unsetenv("SKIPUNS_WEIGHTS")
+	defer os.Unsetenv("SKIPUNS_LIMITS")
+	defer os.Unsetenv("SKIPUNS_PORT")
+
+	if err := (&Config{}).Parse(&mixedConfig{}); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Errorf("Parse without WithSkipUnsupported expected unsupported type error, got %v", err)
+	}
+
+	logger := &recordingLogger{}
+	cfg := &Config{}
+	WithLogger(logger)(cfg)
+	WithSkipUnsupported()(cfg)
+
+	var mc mixedConfig
+	if err := cfg.Parse(&mc); err != nil {
+		t.Fatalf("Parse with WithSkipUnsupported failed: %v", err)
+	}
+	if mc.Name != "api" || mc.Port != 8080 {
+		t.Errorf("Supported fields expected Name=api and Port=8080, got %+v", mc)
+	}
+	if mc.Events != nil || mc.Handler != nil || mc.Weights != nil || mc.Limits != nil {
+		t.Error("Unsupported fields should be left at zero")
+	}
+	if len(logger.messages) != 4 {
+		t.Errorf("Expected 4 skip warnings, got %v", logger.messages)
+	}
+}
+
+// TestScanStruct tests positional assignment of a delimited value to struct fields
+func TestScanStruct(t *testing.T) {
+	type server struct {
+		Host    string
+		Port    int
+		Weight  float64
+		Backup  bool
+		Timeout time.Duration
+		note    string
+	}
+
+	os.Setenv("APP_SERVER", "db.local: 5432 :0.75:true:3s")
+	defer os.Unsetenv("APP_SERVER")
+
+	cfg := &Config{Prefix: "APP_"}
+	var s server
+	if err := cfg.ScanStruct("SERVER", &s, ":"); err != nil {
+		t.Fatalf("ScanStruct failed: %v", err)
+	}
+	expected := server{Host: "db.local", Port: 5432, Weight: 0.75, Backup: true, Timeout: 3 * time.Second}
+	if s != expected {
+		t.Errorf("ScanStruct expected %+v, got %+v", expected, s)
+	}
+
+	os.Setenv("APP_SERVER", "db.local:5432")
+	if err := cfg.ScanStruct("SERVER", &server{}, ":"); err == nil || !strings.Contains(err.Error(), "expected 5") {
+		t.Errorf("ScanStruct with too few parts expected count error, got %v", err)
+	}
+
+	os.Setenv("APP_SERVER", "db.local:abc:0.75:true:3s")
+	if err := cfg.ScanStruct("SERVER", &server{}, ":"); err == nil || !strings.Contains(err.Error(), "Port") {
+		t.Errorf("ScanStruct with invalid part expected error naming the field, got %v", err)
+	}
+
+	if err := cfg.ScanStruct("MISSING", &server{}, ":"); err == nil {
+		t.Error("ScanStruct on missing key expected error")
+	}
+	if err := cfg.ScanStruct("SERVER", server{}, ":"); err == nil {
+		t.Error("ScanStruct with non-pointer expected error")
+	}
+
+	os.Setenv("APP_SERVER", "db.local:5432:0.75:2:3s")
+	numeric := cfg.From(WithNumericBool())
+	if err := numeric.ScanStruct("SERVER", &s, ":"); err != nil || !s.Backup {
+		t.Errorf("ScanStruct with WithNumericBool expected Backup true, got %v (error: %v)", s.Backup, err)
+	}
+}
+
+// TestMissingRequired tests reporting unset required keys without parsing
+func TestMissingRequired(t *testing.T) {
+	type readinessConfig struct {
+		DatabaseURL string `env:"DATABASE_URL" required:"true"`
+		APIKey      string `env:"API_KEY" required:"true"`
+		Region      string `env:"REGION" required:"true" default:"eu-west-1"`
+		LogLevel    string `env:"LOG_LEVEL"`
+	}
+
+	os.Setenv("MR_DATABASE_URL", "postgres://db")
+	defer os.Unsetenv("MR_DATABASE_URL")
+
+	cfg := &Config{Prefix: "MR_"}
+	var rc readinessConfig
+	missing := cfg.MissingRequired(&rc)
+	if !reflect.DeepEqual(missing, []string{"MR_API_KEY"}) {
+		t.Errorf("MissingRequired expected [MR_API_KEY], got %v", missing)
+	}
+	if rc.DatabaseURL != "" {
+		t.Error("MissingRequired should not set fields")
+	}
+
+	strict := &Config{Prefix: "MR_"}
+	WithStrictParse()(strict)
+	if missing := strict.MissingRequired(&rc); !reflect.DeepEqual(missing, []string{"MR_API_KEY", "MR_LOG_LEVEL"}) {
+		t.Errorf("MissingRequired in strict mode expected [MR_API_KEY MR_LOG_LEVEL], got %v", missing)
+	}
+
+	if missing := cfg.MissingRequired(rc); missing != nil {
+		t.Errorf("MissingRequired with non-pointer expected nil, got %v", missing)
+	}
+}
+
+// TestParseJSONStructSlice tests format:"json" on slice-of-struct fields
+func TestParseJSONStructSlice(t *testing.T) {
+	type upstream struct {
+		Host string `json:"host"`
+		Port int    `json:"port"`
+	}
+	type proxyConfig struct {
+		Upstreams []upstream  `env:"JSONSLICE_UPSTREAMS" format:"json"`
+		Backups   []*upstream `env:"JSONSLICE_BACKUPS" format:"json"`
+	}
+
+	os.Setenv("JSONSLICE_UPSTREAMS", `[{"host":"a","port":1},{"host":"b","port":2}]`)
+	os.Setenv("JSONSLICE_BACKUPS", `[{"host":"c","port":3}]`)
+	defer os.Unsetenv("JSONSLICE_UPSTREAMS")
+	defer os.Unsetenv("JSONSLICE_BACKUPS")
+
+	var cfg proxyConfig
+	if err := (&Config{}).Parse(&cfg); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	expectedUpstreams := []upstream{{"a", 1}, {"b", 2}}
+	if !reflect.DeepEqual(cfg.Upstreams, expectedUpstreams) {
+		t.Errorf("Upstreams expected %+v, got %+v", expectedUpstreams, cfg.Upstreams)
+	}
+	expectedBackup := upstream{"c", 3}
+	if len(cfg.Backups) != 1 || *cfg.Backups[0] != expectedBackup {
+		t.Errorf("Backups expected [%+v], got %+v", expectedBackup, cfg.Backups)
+	}
+
+	os.Setenv("JSONSLICE_UPSTREAMS", `[{"host":"a",}]`)
+	if err := (&Config{}).Parse(&proxyConfig{}); err == nil || !strings.Contains(err.Error(), "Upstreams") || !strings.Contains(err.Error(), "JSON") {
+		t.Errorf("Parse with malformed JSON expected error naming the field, got %v", err)
+	}
+}
+
+// TestParseSIFormat tests the format:"si" tag on integer fields
+func TestParseSIFormat(t *testing.T) {
+	type eventsConfig struct {
+		MaxEvents int   `env:"SIFMT_MAX_EVENTS" format:"si"`
+		Budget    int64 `env:"SIFMT_BUDGET" format:"si"`
+	}
+
+	os.Setenv("SIFMT_MAX_EVENTS", "10k")
+	os.Setenv("SIFMT_BUDGET", "2M")
+	defer os.Unsetenv("SIFMT_MAX_EVENTS")
+	defer os.Unsetenv("SIFMT_BUDGET")
+
+	var cfg eventsConfig
+	if err := (&Config{}).Parse(&cfg); err != nil || cfg.MaxEvents != 10000 || cfg.Budget != 2000000 {
+		t.Errorf("Parse with format:\"si\" = %+v, %v", cfg, err)
+	}
+
+	os.Setenv("SIFMT_MAX_EVENTS", "10Ki")
+	if err := (&Config{}).Parse(&eventsConfig{}); err == nil || !strings.Contains(err.Error(), "MaxEvents") {
+		t.Errorf("Parse with unknown suffix expected error naming the field, got %v", err)
+	}
+}
+
+// TestParseJSONSlice tests format:"json" on scalar slice fields
+func TestParseJSONSlice(t *testing.T) {
+	type idsConfig struct {
+		IDs    []int    `env:"JSONARR_IDS" format:"json"`
+		Labels []string `env:"JSONARR_LABELS" format:"json"`
+	}
+
+	os.Setenv("JSONARR_IDS", "[1,2,3]")
+	os.Setenv("JSONARR_LABELS", `["a,b","c"]`)
+	defer os.Unsetenv("JSONARR_IDS")
+	defer os.Unsetenv("JSONARR_LABELS")
+
+	var cfg idsConfig
+	if err := (&Config{}).Parse(&cfg); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	expected := idsConfig{IDs: []int{1, 2, 3}, Labels: []string{"a,b", "c"}}
+	if !reflect.DeepEqual(cfg, expected) {
+		t.Errorf("Parse with format:\"json\" expected %+v, got %+v", expected, cfg)
+	}
+
+	os.Setenv("JSONARR_IDS", "1,2,3")
+	if err := (&Config{}).Parse(&idsConfig{}); err == nil || !strings.Contains(err.Error(), "IDs") {
+		t.Errorf("Parse with non-JSON value expected error naming the field, got %v", err)
+	}
+}
+
+// TestParseRespectEmpty tests WithRespectEmpty for unset, empty, and set variables
+func TestParseRespectEmpty(t *testing.T) {
+	type emptyConfig struct {
+		Unset string `env:"RESPECT_UNSET" default:"fallback"`
+		Empty string `env:"RESPECT_EMPTY" default:"fallback"`
+		Set   string `env:"RESPECT_SET" default:"fallback"`
+	}
+
+	os.Unsetenv("RESPECT_UNSET")
+	os.Setenv("RESPECT_EMPTY", "")
+	os.Setenv("RESPECT_SET", "value")
+	defer os.Unsetenv("RESPECT_EMPTY")
+	defer os.Unsetenv("RESPECT_SET")
+
+	var defaults emptyConfig
+	if err := (&Config{}).Parse(&defaults); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	expected := emptyConfig{Unset: "fallback", Empty: "fallback", Set: "value"}
+	if defaults != expected {
+		t.Errorf("Parse without WithRespectEmpty expected %+v, got %+v", expected, defaults)
+	}
+
+	cfg := &Config{}
+	WithRespectEmpty()(cfg)
+
+	var respected emptyConfig
+	if err := cfg.Parse(&respected); err != nil {
+		t.Fatalf("Parse with WithRespectEmpty failed: %v", err)
+	}
+	expected = emptyConfig{Unset: "fallback", Empty: "", Set: "value"}
+	if respected != expected {
+		t.Errorf("Parse with WithRespectEmpty expected %+v, got %+v", expected, respected)
+	}
+}
+
+// TestDefaultsOf tests reporting declared defaults without reading the environment
+func TestDefaultsOf(t *testing.T) {
+	type docConfig struct {
+		Name  string `env:"NAME" default:"DefaultApp"`
+		Level string `env:"LOG_LEVEL" default:"info" defaultProduction:"warn"`
+		Port  int    `env:"PORT"`
+	}
+
+	os.Setenv("DOC_NAME", "from-env")
+	defer os.Unsetenv("DOC_NAME")
+
+	cfg := &Config{Mode: Production, Prefix: "DOC_"}
+	expected := map[string]string{"DOC_NAME": "DefaultApp", "DOC_LOG_LEVEL": "warn"}
+	if got := cfg.DefaultsOf(&docConfig{}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("DefaultsOf expected %v, got %v", expected, got)
+	}
+
+	origGetDefaultInstance := getDefaultInstance
+	getDefaultInstance = func() (*Config, error) {
+		return &Config{Mode: Development}, nil
+	}
+	defer func() { getDefaultInstance = origGetDefaultInstance }()
+
+	expected = map[string]string{"NAME": "DefaultApp", "LOG_LEVEL": "info"}
+	if got := DefaultsOf(docConfig{}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Package DefaultsOf expected %v, got %v", expected, got)
+	}
+}
+
+// TestParseFieldPrefix tests the per-field prefix tag in override and concatenate modes
+func TestParseFieldPrefix(t *testing.T) {
+	type multiConfig struct {
+		Name   string `env:"NAME"`
+		DBHost string `env:"HOST" prefix:"DB_"`
+		MQHost string `env:"HOST" prefix:"MQ_" default:"mq.local"`
+	}
+
+	os.Setenv("APP_NAME", "app")
+	os.Setenv("DB_HOST", "db.override")
+	os.Setenv("APP_DB_HOST", "db.concat")
+	os.Setenv("APP_MQ_HOST", "mq.concat")
+	defer os.Unsetenv("APP_NAME")
+	defer os.Unsetenv("DB_HOST")
+	defer os.Unsetenv("APP_DB_HOST")
+	defer os.Unsetenv("APP_MQ_HOST")
+
+	t.Run("override", func(t *testing.T) {
+		cfg := &Config{Prefix: "APP_"}
+		var c multiConfig
+		if err := cfg.Parse(&c); err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		expected := multiConfig{Name: "app", DBHost: "db.override", MQHost: "mq.local"}
+		if c != expected {
+			t.Errorf("Parse expected %+v, got %+v", expected, c)
+		}
+		if missing := cfg.MissingRequired(&c); len(missing) != 0 {
+			t.Errorf("MissingRequired expected no keys, got %v", missing)
+		}
+	})
+
+	t.Run("concat", func(t *testing.T) {
+		cfg := &Config{Prefix: "APP_"}
+		WithConcatFieldPrefix()(cfg)
+		var c multiConfig
+		if err := cfg.Parse(&c); err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		expected := multiConfig{Name: "app", DBHost: "db.concat", MQHost: "mq.concat"}
+		if c != expected {
+			t.Errorf("Parse expected %+v, got %+v", expected, c)
+		}
+		expectedDefaults := map[string]string{"APP_MQ_HOST": "mq.local"}
+		if got := cfg.DefaultsOf(&c); !reflect.DeepEqual(got, expectedDefaults) {
+			t.Errorf("DefaultsOf expected %v, got %v", expectedDefaults, got)
+		}
+	})
+}
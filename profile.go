@@ -0,0 +1,97 @@
+package env
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/joho/godotenv"
+)
+
+// profileFiles mengembalikan file yang dimuat oleh WithProfiles: .env sebagai dasar jika
+// ada, lalu .env.<profile> untuk setiap profile sesuai urutan. Profile pertama wajib ada,
+// sedangkan profile berikutnya yang tidak ada hanya menghasilkan peringatan
+func (c *Config) profileFiles() ([]string, error) {
+	paths := c.profilePaths()
+	var files []string
+	if fileExists(paths[0]) {
+		files = append(files, paths[0])
+	}
+
+	for i, profile := range c.profiles {
+		path := paths[i+1]
+		switch {
+		case fileExists(path):
+			files = append(files, path)
+		case i == 0:
+			return nil, fmt.Errorf("file %s untuk profile %s tidak ditemukan", path, profile)
+		default:
+			c.logf("Peringatan: File %s untuk profile %s tidak ditemukan", path, profile)
+		}
+	}
+	return files, nil
+}
+
+// profilePaths mengembalikan path .env dasar diikuti .env.<profile> untuk setiap profile
+// sesuai urutan, tanpa memeriksa apakah file tersebut ada
+func (c *Config) profilePaths() []string {
+	paths := []string{c.locateFile(".env")}
+	for _, profile := range c.profiles {
+		paths = append(paths, c.locateFile(".env."+profile))
+	}
+	return paths
+}
+
+// readLayered membaca files secara berurutan dan menggabungkan nilainya, nilai dari file
+// berikutnya menimpa file sebelumnya
+func readLayered(files []string) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, file := range files {
+		fileValues, err := godotenv.Read(file)
+		if err != nil {
+			return nil, fmt.Errorf("gagal membaca file %s: %v", file, err)
+		}
+		for key, value := range fileValues {
+			values[key] = value
+		}
+	}
+	return values, nil
+}
+
+// loadLayered memuat files secara berlapis: nilai dari file berikutnya menimpa file
+// sebelumnya. Environment proses tetap diutamakan kecuali WithFilePriority aktif
+func (c *Config) loadLayered(files []string) error {
+	values, err := readLayered(files)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range values {
+		if _, exists := os.LookupEnv(key); exists && !c.filePriority {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadedFiles mengembalikan file yang ada dan dimuat oleh Load sesuai urutan: file mode
+// atau file profile, lalu file dari WithExtraFiles. Tidak ada peringatan yang ditulis
+func (c *Config) loadedFiles() []string {
+	var candidates []string
+	if len(c.profiles) > 0 {
+		candidates = c.profilePaths()
+	} else if envFile, err := c.envFile(); err == nil {
+		candidates = []string{envFile}
+	}
+	candidates = append(candidates, c.extraFiles...)
+
+	var files []string
+	for _, file := range candidates {
+		if fileExists(file) {
+			files = append(files, file)
+		}
+	}
+	return files
+}
@@ -0,0 +1,85 @@
+package env
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestWithProfiles tests layered loading of .env and profile files
+func TestWithProfiles(t *testing.T) {
+	tempDir := t.TempDir()
+	oldDir, _ := os.Getwd()
+	os.Chdir(tempDir)
+	defer os.Chdir(oldDir)
+
+	os.WriteFile(".env", []byte("PROFILE_NAME=base\nPROFILE_REGION=local\nPROFILE_MEMORY=1GiB\n"), 0644)
+	os.WriteFile(".env.aws", []byte("PROFILE_REGION=eu-west-1\nPROFILE_MEMORY=2GiB\n"), 0644)
+	os.WriteFile(".env.high-memory", []byte("PROFILE_MEMORY=16GiB\n"), 0644)
+	for _, key := range []string{"PROFILE_NAME", "PROFILE_REGION", "PROFILE_MEMORY", "PROFILE_SHELL"} {
+		defer os.Unsetenv(key)
+	}
+	os.Setenv("PROFILE_SHELL", "shell")
+
+	logger := &recordingLogger{}
+	cfg, err := New(WithProfiles("aws", "missing", "high-memory"), WithLogger(logger))
+	if err != nil {
+		t.Fatalf("New with profiles failed: %v", err)
+	}
+
+	expected := map[string]string{
+		"PROFILE_NAME":   "base",
+		"PROFILE_REGION": "eu-west-1",
+		"PROFILE_MEMORY": "16GiB",
+		"PROFILE_SHELL":  "shell",
+	}
+	for k, v := range expected {
+		if got := os.Getenv(k); got != v {
+			t.Errorf("%s expected '%s', got '%s'", k, v, got)
+		}
+	}
+
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], ".env.missing") {
+		t.Errorf("Expected a warning for the missing profile, got %v", logger.messages)
+	}
+	expectedProfiles := []string{"aws", "missing", "high-memory"}
+	if profiles := cfg.Info().Profiles; !reflect.DeepEqual(profiles, expectedProfiles) {
+		t.Errorf("Info().Profiles expected %v, got %v", expectedProfiles, profiles)
+	}
+	expectedFiles := []string{".env", ".env.aws", ".env.high-memory"}
+	if files := cfg.Info().EnvFiles; !reflect.DeepEqual(files, expectedFiles) {
+		t.Errorf("Info().EnvFiles expected %v, got %v", expectedFiles, files)
+	}
+}
+
+// TestWithProfilesMissingFirst tests that the first profile file is required
+func TestWithProfilesMissingFirst(t *testing.T) {
+	tempDir := t.TempDir()
+	oldDir, _ := os.Getwd()
+	os.Chdir(tempDir)
+	defer os.Chdir(oldDir)
+
+	if _, err := New(WithProfiles("base")); err == nil || !strings.Contains(err.Error(), ".env.base") {
+		t.Errorf("New with missing first profile expected error, got %v", err)
+	}
+}
+
+// TestWithProfilesFilePriority tests that WithFilePriority lets profile files override the process environment
+func TestWithProfilesFilePriority(t *testing.T) {
+	tempDir := t.TempDir()
+	oldDir, _ := os.Getwd()
+	os.Chdir(tempDir)
+	defer os.Chdir(oldDir)
+
+	os.WriteFile(".env.base", []byte("PROFILE_PRIORITY=file\n"), 0644)
+	os.Setenv("PROFILE_PRIORITY", "shell")
+	defer os.Unsetenv("PROFILE_PRIORITY")
+
+	if _, err := New(WithProfiles("base"), WithFilePriority()); err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if got := os.Getenv("PROFILE_PRIORITY"); got != "file" {
+		t.Errorf("PROFILE_PRIORITY expected 'file', got '%s'", got)
+	}
+}
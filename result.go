@@ -1,10 +1,16 @@
 package env
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // result adalah struct untuk hasil operasi dengan validasi
@@ -27,6 +33,147 @@ func (r *result) Required() *result {
 	return r
 }
 
+// OrError sama seperti Required, tetapi memakai err dari pemanggil sebagai error jika nilai
+// kosong, sehingga errors.Is dapat dipakai terhadap error domain seperti ErrNoLicense
+func (r *result) OrError(err error) *result {
+	if r.err != nil {
+		return r
+	}
+
+	if r.value == "" {
+		r.err = err
+	}
+	return r
+}
+
+// RequiredNot menandai bahwa nilai harus ada dan tidak sama dengan salah satu
+// nilai placeholder yang diberikan (misalnya "changeme" atau "TODO").
+// Perbandingan tidak membedakan huruf besar dan kecil
+func (r *result) RequiredNot(values ...string) *result {
+	if r.Required().err != nil {
+		return r
+	}
+
+	for _, placeholder := range values {
+		if strings.EqualFold(r.value, placeholder) {
+			r.err = fmt.Errorf("environment variable %s wajib diisi, nilai %q adalah placeholder", r.key, r.value)
+			break
+		}
+	}
+	return r
+}
+
+// Equals memvalidasi bahwa nilai sama persis dengan expected, misalnya SCHEMA_VERSION yang
+// harus cocok dengan versi binary. Nilai kosong juga dianggap tidak cocok
+func (r *result) Equals(expected string) *result {
+	if r.err != nil {
+		return r
+	}
+
+	if r.value != expected {
+		r.err = fmt.Errorf("environment variable %s harus bernilai %q, ditemukan %q", r.key, expected, r.value)
+	}
+	return r
+}
+
+// EqualsInt memvalidasi bahwa nilai adalah bilangan bulat yang sama dengan expected
+func (r *result) EqualsInt(expected int) *result {
+	if r.err != nil {
+		return r
+	}
+
+	value, err := strconv.Atoi(strings.TrimSpace(r.value))
+	if err != nil || value != expected {
+		r.err = fmt.Errorf("environment variable %s harus bernilai %d, ditemukan %q", r.key, expected, r.value)
+	}
+	return r
+}
+
+// MaxLen memotong nilai menjadi maksimal n rune (bukan byte), sehingga karakter multi-byte
+// tidak terpotong di tengah. n negatif menghasilkan error pada chain
+func (r *result) MaxLen(n int) *result {
+	if r.err != nil {
+		return r
+	}
+	if n < 0 {
+		r.err = fmt.Errorf("batas panjang untuk %s tidak boleh negatif: %d", r.key, n)
+		return r
+	}
+
+	if runes := []rune(r.value); len(runes) > n {
+		r.value = string(runes[:n])
+	}
+	return r
+}
+
+// RequireMaxLen memvalidasi bahwa nilai tidak lebih dari n rune, tanpa memotongnya
+func (r *result) RequireMaxLen(n int) *result {
+	if r.err != nil {
+		return r
+	}
+
+	if length := utf8.RuneCountInString(r.value); length > n {
+		r.err = fmt.Errorf("environment variable %s maksimal %d karakter, ditemukan %d", r.key, n, length)
+	}
+	return r
+}
+
+// HasPrefix memvalidasi bahwa nilai diawali prefix.
+// Nilai kosong tidak divalidasi, gunakan Required untuk mewajibkan nilai
+func (r *result) HasPrefix(prefix string) *result {
+	if r.err != nil || r.value == "" {
+		return r
+	}
+
+	if !strings.HasPrefix(r.value, prefix) {
+		r.err = fmt.Errorf("environment variable %s harus diawali %q", r.key, prefix)
+	}
+	return r
+}
+
+// HasSuffix memvalidasi bahwa nilai diakhiri suffix.
+// Nilai kosong tidak divalidasi, gunakan Required untuk mewajibkan nilai
+func (r *result) HasSuffix(suffix string) *result {
+	if r.err != nil || r.value == "" {
+		return r
+	}
+
+	if !strings.HasSuffix(r.value, suffix) {
+		r.err = fmt.Errorf("environment variable %s harus diakhiri %q", r.key, suffix)
+	}
+	return r
+}
+
+// Contains memvalidasi bahwa nilai mengandung substring sub.
+// Nilai kosong tidak divalidasi, gunakan Required untuk mewajibkan nilai
+func (r *result) Contains(sub string) *result {
+	if r.err != nil || r.value == "" {
+		return r
+	}
+
+	if !strings.Contains(r.value, sub) {
+		r.err = fmt.Errorf("environment variable %s harus mengandung %q", r.key, sub)
+	}
+	return r
+}
+
+// OrKey membaca fallbackKey (dengan prefix) jika nilai kosong, berguna untuk alias
+// seperti env.Key("NEW_NAME").OrKey("OLD_NAME"). Panggil OrKey sebelum Default,
+// karena Default mengisi nilai kosong sehingga OrKey tidak lagi dipakai
+func (r *result) OrKey(fallbackKey string) *result {
+	if r.err != nil || r.value != "" {
+		return r
+	}
+
+	cfg := r.config
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	r.value, r.err = cfg.lookup(cfg.prependPrefix(fallbackKey))
+	return r
+}
+
 // Default menetapkan nilai default
 func (r *result) Default(defaultValue string) *result {
 	if r.err != nil {
@@ -39,12 +186,95 @@ func (r *result) Default(defaultValue string) *result {
 	return r
 }
 
+// DefaultDev menetapkan nilai default hanya jika mode Config bukan production, sehingga
+// production tetap wajib mengisi nilai secara eksplisit. Tanpa Config, mode dideteksi dari environment
+func (r *result) DefaultDev(defaultValue string) *result {
+	var mode string
+	if r.config != nil {
+		mode = r.config.Mode
+	} else {
+		mode = determineDefaultMode()
+	}
+	if mode == Production {
+		return r
+	}
+	return r.Default(defaultValue)
+}
+
+// DefaultFunc menetapkan nilai default dari hasil fn, yang hanya dipanggil jika nilai kosong
+func (r *result) DefaultFunc(fn func() string) *result {
+	if r.err != nil {
+		return r
+	}
+
+	if r.value == "" {
+		r.value = fn()
+	}
+	return r
+}
+
+// DefaultFile menetapkan nilai default dari isi file di path, yang hanya dibaca jika nilai
+// kosong dan belum ada error. Spasi dan baris baru di awal dan akhir isi file dibuang,
+// sama seperti secret dari *_FILE. File yang gagal dibaca menghasilkan error
+func (r *result) DefaultFile(path string) *result {
+	if r.err != nil || r.value != "" {
+		return r
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		r.err = fmt.Errorf("gagal membaca file default %s untuk %s: %v", path, r.key, err)
+		return r
+	}
+	r.value = strings.TrimSpace(string(content))
+	return r
+}
+
+// Err mengembalikan error yang terkumpul di chain tanpa memanggil method terminal.
+// Berguna karena String mengabaikan error dan tetap mengembalikan nilai mentah
+func (r *result) Err() error {
+	return r.err
+}
+
 // String mengembalikan nilai sebagai string
 func (r *result) String() string {
 	return r.value
 }
 
-// Int mengembalikan nilai sebagai int
+// StringOr mengembalikan def jika nilai kosong atau ada error di chain sebelumnya,
+// konsisten dengan IntOr. Berbeda dengan Default(def).String() yang mengabaikan error
+func (r *result) StringOr(def string) string {
+	if r.err != nil || r.value == "" {
+		return def
+	}
+	return r.value
+}
+
+// Redacted mengembalikan nilai yang disamarkan untuk logging, berupa karakter pertama
+// dan terakhir diapit "***" (misalnya "s***t"). Nilai pendek (kurang dari 6 karakter)
+// disamarkan seluruhnya, dan nilai kosong tetap kosong
+func (r *result) Redacted() string {
+	runes := []rune(r.value)
+	switch {
+	case len(runes) == 0:
+		return ""
+	case len(runes) < 6:
+		return RedactedValue
+	default:
+		return string(runes[0]) + "***" + string(runes[len(runes)-1])
+	}
+}
+
+// RedactedFull mengembalikan RedactedValue tanpa membocorkan bagian apa pun dari nilai.
+// Nilai kosong tetap kosong
+func (r *result) RedactedFull() string {
+	if r.value == "" {
+		return ""
+	}
+	return RedactedValue
+}
+
+// Int mengembalikan nilai sebagai int. Tanda eksplisit diterima, sehingga "+42" dibaca 42
 func (r *result) Int() (int, error) {
 	if r.err != nil {
 		return 0, r.err
@@ -54,7 +284,31 @@ func (r *result) Int() (int, error) {
 		return 0, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
 	}
 
-	return strconv.Atoi(r.value)
+	return strconv.Atoi(r.config.numberText(r.value))
+}
+
+// IntSigned sama seperti Int, tetapi nilai wajib diawali tanda eksplisit "+" atau "-",
+// misalnya untuk delta penyesuaian seperti +5 atau -5. Angka tanpa tanda adalah error
+func (r *result) IntSigned() (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	if r.value == "" {
+		return 0, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	value := r.config.numberText(r.value)
+	if !strings.HasPrefix(value, "+") && !strings.HasPrefix(value, "-") {
+		return 0, fmt.Errorf("environment variable %s harus diawali tanda + atau -, ditemukan %q", r.key, value)
+	}
+	return strconv.Atoi(value)
+}
+
+// IntOr mengembalikan nilai sebagai int, atau def jika nilai tidak ada, kosong, atau
+// tidak valid, tanpa perlu Default sebelumnya. Sama seperti IntDefault dan fungsi env.Int
+func (r *result) IntOr(def int) int {
+	return r.IntDefault(def)
 }
 
 // IntDefault mengembalikan nilai sebagai int dengan nilai default
@@ -66,6 +320,46 @@ func (r *result) IntDefault(defaultValue int) int {
 	return value
 }
 
+// Bytes mengembalikan ukuran seperti "512MB" atau "1GiB" sebagai jumlah byte. Satuan desimal
+// (KB, MB, GB, TB) berkelipatan 1000, sedangkan satuan biner (KiB, MiB, GiB, TiB) berkelipatan
+// 1024. Angka tanpa satuan dibaca sebagai byte
+func (r *result) Bytes() (int64, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	if r.value == "" {
+		return 0, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	return parseBytes(r.value)
+}
+
+// IntSI mengembalikan jumlah dengan suffix SI desimal seperti "10k" (1000), "2M" (1.000.000),
+// atau "1G" (1.000.000.000). Angka tanpa suffix dibaca biasa dan suffix lain adalah error.
+// Untuk ukuran memori dengan satuan biner gunakan Bytes
+func (r *result) IntSI() (int64, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	if r.value == "" {
+		return 0, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	return parseSI(r.value)
+}
+
+// BytesDefault mengembalikan ukuran dalam byte dengan nilai default jika nilai tidak ada
+// atau tidak valid, misalnya env.Key("CACHE_SIZE").BytesDefault(64 << 20)
+func (r *result) BytesDefault(defaultValue int64) int64 {
+	value, err := r.Bytes()
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
 // Float64 mengembalikan nilai sebagai float64
 func (r *result) Float64() (float64, error) {
 	if r.err != nil {
@@ -76,7 +370,13 @@ func (r *result) Float64() (float64, error) {
 		return 0, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
 	}
 
-	return strconv.ParseFloat(r.value, 64)
+	return strconv.ParseFloat(r.config.numberText(r.value), 64)
+}
+
+// Float64Or mengembalikan nilai sebagai float64, atau def jika nilai tidak ada, kosong,
+// atau tidak valid. Sama seperti Float64Default
+func (r *result) Float64Or(def float64) float64 {
+	return r.Float64Default(def)
 }
 
 // Float64Default mengembalikan nilai sebagai float64 dengan nilai default
@@ -88,6 +388,61 @@ func (r *result) Float64Default(defaultValue float64) float64 {
 	return value
 }
 
+// Float64Clamped mengembalikan nilai sebagai float64 yang dibatasi dalam rentang
+// [minValue, maxValue]. Nilai yang tidak ada, tidak valid, NaN, atau Inf
+// menghasilkan defaultValue
+func (r *result) Float64Clamped(minValue, maxValue, defaultValue float64) float64 {
+	value, err := r.Float64()
+	if err != nil {
+		return defaultValue
+	}
+	return clampFloat64(value, minValue, maxValue, defaultValue)
+}
+
+// clampFloat64 membatasi value dalam rentang [minValue, maxValue],
+// NaN dan Inf dianggap tidak valid sehingga menghasilkan defaultValue
+func clampFloat64(value, minValue, maxValue, defaultValue float64) float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return defaultValue
+	}
+	if value < minValue {
+		return minValue
+	}
+	if value > maxValue {
+		return maxValue
+	}
+	return value
+}
+
+// Percent mengembalikan nilai persentase sebagai pecahan, misalnya "25%" menjadi 0.25.
+// Nilai tanpa tanda % dibaca apa adanya sebagai float, sehingga "0.25" tetap 0.25
+func (r *result) Percent() (float64, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	if r.value == "" {
+		return 0, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	return parsePercent(r.value)
+}
+
+// parsePercent mengubah "25%" menjadi 0.25, nilai tanpa % dibaca sebagai float biasa
+func parsePercent(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	if strings.HasSuffix(value, "%") {
+		number := strings.TrimSpace(strings.TrimSuffix(value, "%"))
+		percent, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return 0, fmt.Errorf("persentase tidak valid: %q", value)
+		}
+		return percent / 100, nil
+	}
+
+	return strconv.ParseFloat(value, 64)
+}
+
 // Bool mengembalikan nilai sebagai boolean
 func (r *result) Bool() bool {
 	if r.err != nil {
@@ -98,8 +453,21 @@ func (r *result) Bool() bool {
 		return false
 	}
 
-	value := strings.ToLower(r.value)
-	return value == "true" || value == "1" || value == "yes" || value == "y"
+	return r.config.parseBool(r.value)
+}
+
+// BoolE mengembalikan nilai sebagai boolean, dengan error jika nilai tidak diisi.
+// Berguna untuk membedakan nilai "false" yang eksplisit dengan key yang tidak ada
+func (r *result) BoolE() (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+
+	if r.value == "" {
+		return false, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	return r.Bool(), nil
 }
 
 // BoolDefault mengembalikan nilai sebagai boolean dengan nilai default
@@ -110,6 +478,18 @@ func (r *result) BoolDefault(defaultValue bool) bool {
 	return r.Bool()
 }
 
+// BoolOr mengembalikan nilai sebagai boolean, atau def jika nilai tidak ada, kosong,
+// atau tidak dikenali sebagai boolean (misalnya "maybe")
+func (r *result) BoolOr(def bool) bool {
+	if r.err != nil {
+		return def
+	}
+	if b, ok := r.config.lookupBool(r.value); ok {
+		return b
+	}
+	return def
+}
+
 // Duration mengembalikan nilai sebagai time.Duration
 func (r *result) Duration() (time.Duration, error) {
 	if r.err != nil {
@@ -123,6 +503,58 @@ func (r *result) Duration() (time.Duration, error) {
 	return time.ParseDuration(r.value)
 }
 
+// DefaultTimeLayouts adalah layout yang dicoba oleh result.Time dan GetTime jika
+// tidak ada layout yang diberikan
+var DefaultTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// Time mengembalikan nilai sebagai time.Time dengan mencoba setiap layout secara berurutan
+// dan memakai yang pertama berhasil. Tanpa layout, DefaultTimeLayouts yang dipakai
+func (r *result) Time(layouts ...string) (time.Time, error) {
+	if r.err != nil {
+		return time.Time{}, r.err
+	}
+
+	if r.value == "" {
+		return time.Time{}, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	if len(layouts) == 0 {
+		layouts = DefaultTimeLayouts
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, r.value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("environment variable %s bukan waktu yang valid, layout yang dicoba: %s",
+		r.key, strings.Join(layouts, ", "))
+}
+
+// ISODuration mengembalikan nilai durasi ISO-8601 (misalnya PT1H30M) sebagai time.Duration
+func (r *result) ISODuration() (time.Duration, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	if r.value == "" {
+		return 0, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	return parseISODuration(r.value)
+}
+
+// DurationOr mengembalikan nilai sebagai time.Duration, atau def jika nilai tidak ada,
+// kosong, atau tidak valid. Sama seperti DurationDefault
+func (r *result) DurationOr(def time.Duration) time.Duration {
+	return r.DurationDefault(def)
+}
+
 // DurationDefault mengembalikan nilai sebagai time.Duration dengan nilai default
 func (r *result) DurationDefault(defaultValue time.Duration) time.Duration {
 	value, err := r.Duration()
@@ -132,14 +564,77 @@ func (r *result) DurationDefault(defaultValue time.Duration) time.Duration {
 	return value
 }
 
+// DurationBounded mengembalikan nilai sebagai time.Duration yang dibatasi dalam rentang
+// [minValue, maxValue]. Nilai di luar rentang dipotong ke batas terdekat (bukan error),
+// sedangkan nilai yang tidak ada atau tidak valid menghasilkan defaultValue
+func (r *result) DurationBounded(minValue, maxValue, defaultValue time.Duration) time.Duration {
+	value, err := r.Duration()
+	if err != nil {
+		return defaultValue
+	}
+	return clampDuration(value, minValue, maxValue)
+}
+
+// DurationSum mengembalikan jumlah dari setiap elemen durasi, misalnya "1h,30m,15s" menjadi
+// 1h30m15s. Elemen di-trim, dan elemen kosong atau tidak valid menghasilkan error. Total yang
+// melebihi rentang time.Duration (sekitar 292 tahun) menghasilkan error, bukan overflow
+func (r *result) DurationSum(delimiter string) (time.Duration, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	if r.value == "" {
+		return 0, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	var total time.Duration
+	for i, part := range r.Slice(delimiter) {
+		d, err := time.ParseDuration(part)
+		if err != nil {
+			return 0, fmt.Errorf("environment variable %s memiliki durasi tidak valid pada indeks %d: %v", r.key, i, err)
+		}
+		if d > 0 && total > math.MaxInt64-d || d < 0 && total < math.MinInt64-d {
+			return 0, fmt.Errorf("environment variable %s: total durasi melebihi batas time.Duration", r.key)
+		}
+		total += d
+	}
+	return total, nil
+}
+
+// DurationInRange mengembalikan nilai sebagai time.Duration, dengan error jika nilai berada
+// di luar rentang [minValue, maxValue]. Berbeda dengan DurationBounded yang memotong nilai,
+// di sini nilai di luar rentang dianggap konfigurasi yang salah
+func (r *result) DurationInRange(minValue, maxValue time.Duration) (time.Duration, error) {
+	value, err := r.Duration()
+	if err != nil {
+		return 0, err
+	}
+
+	if value < minValue || value > maxValue {
+		return 0, fmt.Errorf("environment variable %s harus berada dalam rentang [%v, %v], ditemukan %v", r.key, minValue, maxValue, value)
+	}
+	return value, nil
+}
+
+// clampDuration membatasi value dalam rentang [minValue, maxValue]
+func clampDuration(value, minValue, maxValue time.Duration) time.Duration {
+	if value < minValue {
+		return minValue
+	}
+	if value > maxValue {
+		return maxValue
+	}
+	return value
+}
+
 // Slice mengembalikan nilai sebagai slice string
 func (r *result) Slice(delimiter string) []string {
 	if r.err != nil {
-		return []string{}
+		return r.config.emptySlice()
 	}
 
 	if r.value == "" {
-		return []string{}
+		return r.config.emptySlice()
 	}
 
 	if delimiter == "" {
@@ -155,6 +650,98 @@ func (r *result) Slice(delimiter string) []string {
 	return parts
 }
 
+// SliceLower sama seperti Slice, tetapi setiap elemen diubah ke huruf kecil. Berguna untuk
+// menormalkan allowlist hostname atau region sebelum perbandingan case-insensitive
+func (r *result) SliceLower(delimiter string) []string {
+	return mapStrings(r.Slice(delimiter), strings.ToLower)
+}
+
+// SliceUpper sama seperti Slice, tetapi setiap elemen diubah ke huruf besar
+func (r *result) SliceUpper(delimiter string) []string {
+	return mapStrings(r.Slice(delimiter), strings.ToUpper)
+}
+
+// mapStrings menerapkan fn ke setiap elemen items secara langsung
+func mapStrings(items []string, fn func(string) string) []string {
+	for i, item := range items {
+		items[i] = fn(item)
+	}
+	return items
+}
+
+// SliceIndexedMap mengembalikan nilai slice sebagai map indeks ke elemen, misalnya "a,b,c"
+// menjadi {0:"a", 1:"b", 2:"c"}. Elemen di-trim, dan elemen kosong tidak dimuat tanpa
+// menggeser indeks elemen setelahnya ("a,,c" menjadi {0:"a", 2:"c"}). Nilai kosong
+// menghasilkan map kosong
+func (r *result) SliceIndexedMap(delimiter string) map[int]string {
+	items := make(map[int]string)
+	if r.err != nil || r.value == "" {
+		return items
+	}
+
+	for i, part := range r.Slice(delimiter) {
+		if part != "" {
+			items[i] = part
+		}
+	}
+	return items
+}
+
+// SliceMin mengembalikan elemen tidak kosong dari nilai slice, dengan error jika jumlahnya
+// kurang dari minItems. Berguna untuk allowlist yang tidak boleh kosong seperti ALLOWED_HOSTS=
+func (r *result) SliceMin(delimiter string, minItems int) ([]string, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	items := make([]string, 0)
+	for _, part := range r.Slice(delimiter) {
+		if part != "" {
+			items = append(items, part)
+		}
+	}
+
+	if len(items) < minItems {
+		return nil, fmt.Errorf("environment variable %s harus berisi minimal %d elemen, ditemukan %d", r.key, minItems, len(items))
+	}
+	return items, nil
+}
+
+// SliceRequireAll mengembalikan nilai sebagai slice string, dengan error jika ada elemen
+// yang kosong setelah di-trim (misalnya "a,,c"). Berbeda dengan SliceMin yang membuang
+// elemen kosong, di sini elemen kosong dianggap konfigurasi yang rusak
+func (r *result) SliceRequireAll(delimiter string) ([]string, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if r.value == "" {
+		return nil, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	items := r.Slice(delimiter)
+	for i, item := range items {
+		if item == "" {
+			return nil, fmt.Errorf("environment variable %s memiliki elemen kosong pada indeks %d", r.key, i)
+		}
+	}
+	return items, nil
+}
+
+// StringListRaw mengembalikan nilai sebagai slice string tanpa trim spasi dan tanpa
+// membuang elemen kosong, sehingga data posisional tetap utuh
+func (r *result) StringListRaw(delimiter string) []string {
+	if r.err != nil || r.value == "" {
+		return r.config.emptySlice()
+	}
+
+	if delimiter == "" {
+		delimiter = ","
+	}
+
+	return strings.Split(r.value, delimiter)
+}
+
 // SliceDefault mengembalikan nilai sebagai slice string dengan nilai default
 func (r *result) SliceDefault(delimiter string, defaultValue []string) []string {
 	if r.err != nil || r.value == "" {
@@ -165,6 +752,14 @@ func (r *result) SliceDefault(delimiter string, defaultValue []string) []string
 
 // Map mengembalikan nilai sebagai map[string]string
 func (r *result) Map() map[string]string {
+	return r.MapKV(":")
+}
+
+// MapKV mengembalikan nilai sebagai map[string]string dengan pemisah key/value kvsep
+// (defaultnya ":"). Pasangan dipisahkan dengan koma dan hanya dipisah pada kvsep
+// pertama, sehingga nilai berupa URL tetap utuh: primary=http://a:5432.
+// Pasangan dengan kvsep di akhir menghasilkan nilai kosong
+func (r *result) MapKV(kvsep string) map[string]string {
 	if r.err != nil {
 		return map[string]string{}
 	}
@@ -173,11 +768,15 @@ func (r *result) Map() map[string]string {
 		return map[string]string{}
 	}
 
+	if kvsep == "" {
+		kvsep = ":"
+	}
+
 	result := make(map[string]string)
 	parts := strings.Split(r.value, ",")
 
 	for _, part := range parts {
-		keyValue := strings.SplitN(part, ":", 2)
+		keyValue := strings.SplitN(part, kvsep, 2)
 		if len(keyValue) == 2 {
 			k := strings.TrimSpace(keyValue[0])
 			v := strings.TrimSpace(keyValue[1])
@@ -188,6 +787,25 @@ func (r *result) Map() map[string]string {
 	return result
 }
 
+// MapInto mengisi m dengan pasangan dari nilai (format key1:value1,key2:value2), sehingga
+// entri dari environment dapat digabung ke map default. Key yang sudah ada di m tidak
+// ditimpa kecuali overwrite bernilai true. Jika chain sudah error, m tidak diubah dan
+// error tersebut dikembalikan
+func (r *result) MapInto(m map[string]string, overwrite ...bool) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	force := len(overwrite) > 0 && overwrite[0]
+	for k, v := range r.Map() {
+		if _, exists := m[k]; exists && !force {
+			continue
+		}
+		m[k] = v
+	}
+	return nil
+}
+
 // MapDefault mengembalikan nilai sebagai map[string]string dengan nilai default
 func (r *result) MapDefault(defaultValue map[string]string) map[string]string {
 	if r.err != nil || r.value == "" {
@@ -195,3 +813,235 @@ func (r *result) MapDefault(defaultValue map[string]string) map[string]string {
 	}
 	return r.Map()
 }
+
+// Pairs mengembalikan nilai sebagai daftar pasangan key/value dengan urutan tetap
+// dan key duplikat dipertahankan, misalnya header "X-A:1,X-B:2"
+func (r *result) Pairs(pairDelim, kvDelim string) []KeyValue {
+	if r.err != nil {
+		return []KeyValue{}
+	}
+	return parsePairs(r.value, pairDelim, kvDelim)
+}
+
+// SliceMap mengembalikan nilai sebagai map ke list, misalnya "web:a.com|b.com,api:c.com"
+// menjadi {"web": ["a.com", "b.com"], "api": ["c.com"]}. pairDelim memisahkan pasangan
+// (defaultnya ","), kvDelim memisahkan key dari list (defaultnya ":"), dan listDelim
+// memisahkan elemen list (defaultnya "|"). Key dan elemen di-trim, elemen kosong dibuang,
+// pasangan tanpa kvDelim dilewati, dan key yang muncul berulang digabung
+func (r *result) SliceMap(pairDelim, kvDelim, listDelim string) map[string][]string {
+	result := make(map[string][]string)
+	if r.err != nil {
+		return result
+	}
+
+	if listDelim == "" {
+		listDelim = "|"
+	}
+
+	for _, pair := range parsePairs(r.value, pairDelim, kvDelim) {
+		items := result[pair.Key]
+		if items == nil {
+			items = []string{}
+		}
+		for _, item := range strings.Split(pair.Value, listDelim) {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		result[pair.Key] = items
+	}
+	return result
+}
+
+// KeyValue adalah satu pasangan key/value hasil GetPairs atau Pairs
+type KeyValue struct {
+	Key   string
+	Value string
+}
+
+// parsePairs memecah value menjadi pasangan dengan pemisah pairDelim (defaultnya ",")
+// dan kvDelim (defaultnya ":"). Key dan value di-trim, setiap pasangan hanya dipisah
+// pada kvDelim pertama, dan pasangan tanpa kvDelim dilewati
+func parsePairs(value, pairDelim, kvDelim string) []KeyValue {
+	pairs := []KeyValue{}
+	if value == "" {
+		return pairs
+	}
+
+	if pairDelim == "" {
+		pairDelim = ","
+	}
+	if kvDelim == "" {
+		kvDelim = ":"
+	}
+
+	for _, part := range strings.Split(value, pairDelim) {
+		keyValue := strings.SplitN(part, kvDelim, 2)
+		if len(keyValue) == 2 {
+			pairs = append(pairs, KeyValue{
+				Key:   strings.TrimSpace(keyValue[0]),
+				Value: strings.TrimSpace(keyValue[1]),
+			})
+		}
+	}
+
+	return pairs
+}
+
+// URLParams mengembalikan parameter query dari nilai berupa URL, misalnya
+// redis://host:6379?db=2&pool=10 menjadi {"db": ["2"], "pool": ["10"]}. Nilai kosong
+// dan URL atau query yang tidak valid menghasilkan error
+func (r *result) URLParams() (url.Values, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if r.value == "" {
+		return nil, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	u, err := url.Parse(r.value)
+	if err != nil {
+		return nil, fmt.Errorf("environment variable %s bukan URL yang valid: %v", r.key, err)
+	}
+
+	params, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("environment variable %s memiliki query yang tidak valid: %v", r.key, err)
+	}
+	return params, nil
+}
+
+// URLParam mengembalikan parameter query name dari nilai berupa URL sebagai result baru,
+// sehingga dapat dibaca dengan method bertipe seperti Int atau Duration, misalnya
+// env.Key("REDIS_URL").URLParam("db").IntDefault(0). Parameter yang tidak ada bernilai kosong
+func (r *result) URLParam(name string) *result {
+	param := &result{config: r.config, key: r.key + "?" + name}
+
+	params, err := r.URLParams()
+	if err != nil {
+		param.err = err
+		return param
+	}
+	param.value = params.Get(name)
+	return param
+}
+
+// JSON mendecode nilai JSON ke dalam out
+func (r *result) JSON(out interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.value == "" {
+		return fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	if err := json.Unmarshal([]byte(r.value), out); err != nil {
+		return fmt.Errorf("environment variable %s bukan JSON yang valid: %v", r.key, err)
+	}
+	return nil
+}
+
+// JSONSlice mendecode array JSON seperti [1,2,3] atau ["a,b","c"] ke dalam out, yang harus
+// berupa pointer ke slice. Berguna jika elemen dapat berisi koma sehingga format Slice ambigu
+func (r *result) JSONSlice(out interface{}) error {
+	if t := reflect.TypeOf(out); t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("JSONSlice membutuhkan pointer ke slice, bukan %T", out)
+	}
+	return r.JSON(out)
+}
+
+// Scanf mengurai nilai ke dalam targets menggunakan fmt.Sscanf dengan format yang diberikan,
+// misalnya env.Key("NODE").Scanf("%s %d", &host, &port)
+func (r *result) Scanf(format string, targets ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.value == "" {
+		return fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	if _, err := fmt.Sscanf(r.value, format, targets...); err != nil {
+		return fmt.Errorf("environment variable %s tidak sesuai format %q: %v", r.key, format, err)
+	}
+	return nil
+}
+
+// UintN mengembalikan nilai sebagai bilangan bulat tak bertanda dengan lebar bitSize
+// dan basis base, misalnya UintN(16, 16) untuk port 16-bit dalam heksadesimal. Base 0
+// mengikuti prefix Go (0x, 0o, 0b). Nilai negatif atau di luar rentang menghasilkan error
+func (r *result) UintN(bitSize int, base int) (uint64, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	if r.value == "" {
+		return 0, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	value, err := strconv.ParseUint(strings.TrimSpace(r.value), base, bitSize)
+	if err != nil {
+		if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+			return 0, fmt.Errorf("environment variable %s di luar rentang uint%d: %s", r.key, bitSize, numErr.Num)
+		}
+		return 0, fmt.Errorf("environment variable %s bukan uint%d basis %d yang valid: %v", r.key, bitSize, base, err)
+	}
+	return value, nil
+}
+
+// Numeric adalah constraint untuk semua tipe integer dan float
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// As mengonversi nilai result ke tipe numerik T dengan pemeriksaan overflow.
+// Nilai di luar rentang T menghasilkan error
+func As[T Numeric](r *result) (T, error) {
+	var zero T
+	if r.err != nil {
+		return zero, r.err
+	}
+
+	if r.value == "" {
+		return zero, fmt.Errorf("environment variable %s tidak ditemukan", r.key)
+	}
+
+	typ := reflect.TypeOf(zero)
+	out := reflect.New(typ).Elem()
+	value := r.config.numberText(r.value)
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(value, 10, typ.Bits())
+		if err != nil {
+			return zero, numericError(r.key, typ, err)
+		}
+		out.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, err := strconv.ParseUint(value, 10, typ.Bits())
+		if err != nil {
+			return zero, numericError(r.key, typ, err)
+		}
+		out.SetUint(v)
+	default:
+		v, err := strconv.ParseFloat(value, typ.Bits())
+		if err != nil {
+			return zero, numericError(r.key, typ, err)
+		}
+		out.SetFloat(v)
+	}
+
+	return out.Interface().(T), nil
+}
+
+// numericError membuat error yang jelas untuk kegagalan konversi numerik
+func numericError(key string, typ reflect.Type, err error) error {
+	if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+		return fmt.Errorf("environment variable %s di luar rentang %s: %s", key, typ, numErr.Num)
+	}
+	return fmt.Errorf("environment variable %s bukan %s yang valid: %v", key, typ, err)
+}
@@ -3,7 +3,11 @@ package env
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -736,3 +740,1156 @@ func TestIntDefaultVariations(t *testing.T) {
 		t.Errorf("IntDefault() with empty value expected 100, got %d", val)
 	}
 }
+
+// TestResultJSON tests decoding JSON values through the fluent API
+func TestResultJSON(t *testing.T) {
+	type flags struct {
+		Beta  bool   `json:"beta"`
+		Theme string `json:"theme"`
+	}
+
+	// Valid JSON
+	var f flags
+	r := createTestResult(`{"beta":true,"theme":"dark"}`)
+	if err := r.Required().JSON(&f); err != nil {
+		t.Fatalf("JSON() unexpected error: %v", err)
+	}
+	if !f.Beta || f.Theme != "dark" {
+		t.Errorf("JSON() expected {true dark}, got %+v", f)
+	}
+
+	// Invalid JSON
+	r = createTestResult(`{"beta":`)
+	if err := r.JSON(&f); err == nil || !strings.Contains(err.Error(), "bukan JSON yang valid") {
+		t.Errorf("JSON() with invalid value expected JSON error, got %v", err)
+	}
+
+	// Empty value after Required should surface the required error
+	r = createTestResult("")
+	err := r.Required().JSON(&f)
+	if err == nil || err.Error() != "environment variable TEST_KEY wajib diisi" {
+		t.Errorf("Required().JSON() expected required error, got %v", err)
+	}
+
+	// Empty value without Required
+	r = createTestResult("")
+	if err := r.JSON(&f); err == nil {
+		t.Error("JSON() with empty value should return error")
+	}
+
+	// Prior error is propagated
+	r = createTestResult(`{"beta":true}`)
+	r.err = errors.New("initial error")
+	if err := r.JSON(&f); err == nil || err.Error() != "initial error" {
+		t.Errorf("JSON() expected 'initial error', got %v", err)
+	}
+}
+
+// TestResultRequiredNot tests rejecting placeholder values
+func TestResultRequiredNot(t *testing.T) {
+	// Real value passes
+	r := createTestResult("s3cr3t")
+	if val := r.RequiredNot("changeme", "TODO").String(); val != "s3cr3t" || r.err != nil {
+		t.Errorf("RequiredNot() with real value expected 's3cr3t', got '%s' (error: %v)", val, r.err)
+	}
+
+	// Placeholder values fail, case-insensitively
+	for _, value := range []string{"changeme", "CHANGEME", "todo"} {
+		r = createTestResult(value)
+		if r.RequiredNot("changeme", "TODO").err == nil {
+			t.Errorf("RequiredNot() with placeholder '%s' should set error", value)
+		}
+	}
+
+	// Empty value fails with the required error
+	r = createTestResult("")
+	r.RequiredNot("changeme")
+	if r.err == nil || r.err.Error() != "environment variable TEST_KEY wajib diisi" {
+		t.Errorf("RequiredNot() with empty value expected required error, got %v", r.err)
+	}
+
+	// Error stays in the chain
+	r = createTestResult("changeme")
+	if _, err := r.RequiredNot("changeme").Default("fallback").Int(); err == nil {
+		t.Error("RequiredNot().Default().Int() should keep the placeholder error")
+	}
+
+	// Prior error is preserved
+	r = createTestResult("changeme")
+	r.err = errors.New("initial error")
+	if r.RequiredNot("changeme").err.Error() != "initial error" {
+		t.Errorf("RequiredNot() should preserve prior error, got %v", r.err)
+	}
+}
+
+// TestResultBoolE tests distinguishing explicit false from unset values
+func TestResultBoolE(t *testing.T) {
+	r := createTestResult("true")
+	if val, err := r.BoolE(); err != nil || !val {
+		t.Errorf("BoolE() with 'true' expected (true, nil), got (%v, %v)", val, err)
+	}
+
+	r = createTestResult("false")
+	if val, err := r.BoolE(); err != nil || val {
+		t.Errorf("BoolE() with 'false' expected (false, nil), got (%v, %v)", val, err)
+	}
+
+	r = createTestResult("")
+	if val, err := r.BoolE(); err == nil || val {
+		t.Errorf("BoolE() with empty value expected (false, error), got (%v, %v)", val, err)
+	}
+
+	// Default fills the value before BoolE
+	r = createTestResult("")
+	if val, err := r.Default("yes").BoolE(); err != nil || !val {
+		t.Errorf("Default().BoolE() expected (true, nil), got (%v, %v)", val, err)
+	}
+
+	// Required error is surfaced
+	r = createTestResult("")
+	if _, err := r.Required().BoolE(); err == nil || err.Error() != "environment variable TEST_KEY wajib diisi" {
+		t.Errorf("Required().BoolE() expected required error, got %v", err)
+	}
+
+	// Prior error is propagated
+	r = createTestResult("true")
+	r.err = errors.New("initial error")
+	if val, err := r.BoolE(); val || err == nil || err.Error() != "initial error" {
+		t.Errorf("BoolE() expected (false, initial error), got (%v, %v)", val, err)
+	}
+}
+
+// TestResultFloat64Clamped tests clamping float values into a range
+func TestResultFloat64Clamped(t *testing.T) {
+	cases := map[string]struct {
+		value    string
+		expected float64
+	}{
+		"in range":     {"0.25", 0.25},
+		"below min":    {"-0.5", 0},
+		"above max":    {"1.5", 1},
+		"boundary":     {"1", 1},
+		"empty":        {"", 0.1},
+		"invalid":      {"abc", 0.1},
+		"NaN":          {"NaN", 0.1},
+		"Inf":          {"Inf", 0.1},
+		"negative Inf": {"-Inf", 0.1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := createTestResult(tc.value)
+			if val := r.Float64Clamped(0, 1, 0.1); val != tc.expected {
+				t.Errorf("Float64Clamped() for '%s' expected %v, got %v", tc.value, tc.expected, val)
+			}
+		})
+	}
+
+	// Prior error yields the default
+	r := createTestResult("0.5")
+	r.err = errors.New("initial error")
+	if val := r.Float64Clamped(0, 1, 0.1); val != 0.1 {
+		t.Errorf("Float64Clamped() with error expected 0.1, got %v", val)
+	}
+}
+
+// TestResultStringListRaw tests splitting without trimming or dropping empties
+func TestResultStringListRaw(t *testing.T) {
+	r := createTestResult(" a ,,b , ")
+	expected := []string{" a ", "", "b ", " "}
+	if val := r.StringListRaw(","); !equalSlices(val, expected) {
+		t.Errorf("StringListRaw() expected %q, got %q", expected, val)
+	}
+
+	// Default delimiter
+	r = createTestResult("x,,y")
+	if val := r.StringListRaw(""); !equalSlices(val, []string{"x", "", "y"}) {
+		t.Errorf("StringListRaw(\"\") expected [x  y], got %q", val)
+	}
+
+	// Custom delimiter
+	r = createTestResult("1;;3")
+	if val := r.StringListRaw(";"); !equalSlices(val, []string{"1", "", "3"}) {
+		t.Errorf("StringListRaw(;) expected [1  3], got %q", val)
+	}
+
+	// Empty value and error
+	r = createTestResult("")
+	if val := r.StringListRaw(","); len(val) != 0 {
+		t.Errorf("StringListRaw() with empty value expected empty slice, got %q", val)
+	}
+	r = createTestResult("a,b")
+	r.err = errors.New("initial error")
+	if val := r.StringListRaw(","); len(val) != 0 {
+		t.Errorf("StringListRaw() with error expected empty slice, got %q", val)
+	}
+}
+
+// TestAsGeneric tests generic numeric conversion with overflow checking
+func TestAsGeneric(t *testing.T) {
+	// Valid values for each width
+	if v, err := As[int8](createTestResult("127")); err != nil || v != 127 {
+		t.Errorf("As[int8](127) expected 127, got %d (error: %v)", v, err)
+	}
+	if v, err := As[int16](createTestResult("-32768")); err != nil || v != -32768 {
+		t.Errorf("As[int16](-32768) expected -32768, got %d (error: %v)", v, err)
+	}
+	if v, err := As[int32](createTestResult("2147483647")); err != nil || v != 2147483647 {
+		t.Errorf("As[int32] expected 2147483647, got %d (error: %v)", v, err)
+	}
+	if v, err := As[int64](createTestResult("9223372036854775807")); err != nil || v != 9223372036854775807 {
+		t.Errorf("As[int64] expected max int64, got %d (error: %v)", v, err)
+	}
+	if v, err := As[uint8](createTestResult("255")); err != nil || v != 255 {
+		t.Errorf("As[uint8](255) expected 255, got %d (error: %v)", v, err)
+	}
+	if v, err := As[uint16](createTestResult("65535")); err != nil || v != 65535 {
+		t.Errorf("As[uint16](65535) expected 65535, got %d (error: %v)", v, err)
+	}
+	if v, err := As[uint32](createTestResult("4294967295")); err != nil || v != 4294967295 {
+		t.Errorf("As[uint32] expected 4294967295, got %d (error: %v)", v, err)
+	}
+	if v, err := As[uint64](createTestResult("18446744073709551615")); err != nil || v != 18446744073709551615 {
+		t.Errorf("As[uint64] expected max uint64, got %d (error: %v)", v, err)
+	}
+	if v, err := As[float32](createTestResult("1.5")); err != nil || v != 1.5 {
+		t.Errorf("As[float32](1.5) expected 1.5, got %v (error: %v)", v, err)
+	}
+	if v, err := As[float64](createTestResult("3.14")); err != nil || v != 3.14 {
+		t.Errorf("As[float64](3.14) expected 3.14, got %v (error: %v)", v, err)
+	}
+
+	// Named types are supported
+	type Port uint16
+	if v, err := As[Port](createTestResult("8080")); err != nil || v != 8080 {
+		t.Errorf("As[Port](8080) expected 8080, got %d (error: %v)", v, err)
+	}
+
+	// Overflow for each width
+	overflows := map[string]func() error{
+		"int8":    func() error { _, err := As[int8](createTestResult("128")); return err },
+		"int16":   func() error { _, err := As[int16](createTestResult("32768")); return err },
+		"int32":   func() error { _, err := As[int32](createTestResult("-2147483649")); return err },
+		"int64":   func() error { _, err := As[int64](createTestResult("9223372036854775808")); return err },
+		"uint8":   func() error { _, err := As[uint8](createTestResult("256")); return err },
+		"uint16":  func() error { _, err := As[uint16](createTestResult("65536")); return err },
+		"uint32":  func() error { _, err := As[uint32](createTestResult("4294967296")); return err },
+		"uint64":  func() error { _, err := As[uint64](createTestResult("18446744073709551616")); return err },
+		"float32": func() error { _, err := As[float32](createTestResult("1e39")); return err },
+	}
+	for name, fn := range overflows {
+		err := fn()
+		if err == nil || !strings.Contains(err.Error(), "di luar rentang") {
+			t.Errorf("As[%s] overflow expected range error, got %v", name, err)
+		}
+	}
+
+	// Invalid syntax, negative unsigned, empty value and prior error
+	if _, err := As[int](createTestResult("abc")); err == nil || !strings.Contains(err.Error(), "bukan int yang valid") {
+		t.Errorf("As[int](abc) expected syntax error, got %v", err)
+	}
+	if _, err := As[uint](createTestResult("-1")); err == nil {
+		t.Error("As[uint](-1) should fail")
+	}
+	if _, err := As[int](createTestResult("")); err == nil {
+		t.Error("As[int] with empty value should fail")
+	}
+	r := createTestResult("1")
+	r.err = errors.New("initial error")
+	if _, err := As[int](r); err == nil || err.Error() != "initial error" {
+		t.Errorf("As[int] expected 'initial error', got %v", err)
+	}
+}
+
+// TestResultDurationBounded tests clamping durations into a range
+func TestResultDurationBounded(t *testing.T) {
+	cases := map[string]struct {
+		value    string
+		expected time.Duration
+	}{
+		"in range":  {"45s", 45 * time.Second},
+		"below min": {"100ms", time.Second},
+		"above max": {"24h", 5 * time.Minute},
+		"empty":     {"", 30 * time.Second},
+		"invalid":   {"soon", 30 * time.Second},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := createTestResult(tc.value)
+			val := r.DurationBounded(time.Second, 5*time.Minute, 30*time.Second)
+			if val != tc.expected {
+				t.Errorf("DurationBounded() for '%s' expected %v, got %v", tc.value, tc.expected, val)
+			}
+		})
+	}
+
+	r := createTestResult("1m")
+	r.err = errors.New("initial error")
+	if val := r.DurationBounded(time.Second, time.Hour, 30*time.Second); val != 30*time.Second {
+		t.Errorf("DurationBounded() with error expected 30s, got %v", val)
+	}
+}
+
+// TestResultStringValidators tests HasPrefix, HasSuffix and Contains
+func TestResultStringValidators(t *testing.T) {
+	url := "postgres://user@db.local:5432/app"
+
+	r := createTestResult(url)
+	if val := r.Required().HasPrefix("postgres://").HasSuffix("/app").Contains("@db.local").String(); val != url || r.err != nil {
+		t.Errorf("Valid chain expected '%s', got '%s' (error: %v)", url, val, r.err)
+	}
+
+	checks := map[string]func(*result) *result{
+		"HasPrefix": func(r *result) *result { return r.HasPrefix("mysql://") },
+		"HasSuffix": func(r *result) *result { return r.HasSuffix("/other") },
+		"Contains":  func(r *result) *result { return r.Contains("example.com") },
+	}
+
+	for name, check := range checks {
+		t.Run(name, func(t *testing.T) {
+			// Failing check sets error
+			r := check(createTestResult(url))
+			if r.err == nil || !strings.Contains(r.err.Error(), "TEST_KEY") {
+				t.Errorf("%s() with mismatching value expected error, got %v", name, r.err)
+			}
+
+			// Empty value is not validated
+			if r := check(createTestResult("")); r.err != nil {
+				t.Errorf("%s() with empty value expected no error, got %v", name, r.err)
+			}
+
+			// Prior error is preserved
+			r = createTestResult(url)
+			r.err = errors.New("initial error")
+			if check(r).err.Error() != "initial error" {
+				t.Errorf("%s() should preserve prior error, got %v", name, r.err)
+			}
+		})
+	}
+
+	// Validation applies to defaults
+	r = createTestResult("")
+	if _, err := r.Default("http://x").HasPrefix("https://").Int(); err == nil || !strings.Contains(err.Error(), "harus diawali") {
+		t.Errorf("Default().HasPrefix() expected prefix error, got %v", err)
+	}
+}
+
+// TestResultMapKV tests map parsing with a custom key/value separator
+func TestResultMapKV(t *testing.T) {
+	r := createTestResult("primary=http://a:5432, replica = http://b:5432,empty=,invalid")
+	expected := map[string]string{
+		"primary": "http://a:5432",
+		"replica": "http://b:5432",
+		"empty":   "",
+	}
+	if val := r.MapKV("="); !equalMaps(val, expected) {
+		t.Errorf("MapKV(=) expected %v, got %v", expected, val)
+	}
+
+	// Default separator behaves like Map
+	r = createTestResult("k1:v1,k2:")
+	if val := r.MapKV(""); !equalMaps(val, map[string]string{"k1": "v1", "k2": ""}) {
+		t.Errorf("MapKV(\"\") expected map[k1:v1 k2:], got %v", val)
+	}
+	if !equalMaps(r.Map(), r.MapKV(":")) {
+		t.Errorf("Map() and MapKV(:) should match, got %v and %v", r.Map(), r.MapKV(":"))
+	}
+
+	// Multi-character separator
+	r = createTestResult("a=>1,b=>2")
+	if val := r.MapKV("=>"); !equalMaps(val, map[string]string{"a": "1", "b": "2"}) {
+		t.Errorf("MapKV(=>) expected map[a:1 b:2], got %v", val)
+	}
+
+	// Empty value and error
+	if val := createTestResult("").MapKV("="); len(val) != 0 {
+		t.Errorf("MapKV() with empty value expected empty map, got %v", val)
+	}
+	r = createTestResult("a=1")
+	r.err = errors.New("initial error")
+	if val := r.MapKV("="); len(val) != 0 {
+		t.Errorf("MapKV() with error expected empty map, got %v", val)
+	}
+}
+
+// TestResultOrKey tests falling back to another env key
+func TestResultOrKey(t *testing.T) {
+	os.Setenv("ORKEY_OLD_NAME", "legacy")
+	os.Setenv("ORKEY_NEW_NAME", "current")
+	defer func() {
+		os.Unsetenv("ORKEY_OLD_NAME")
+		os.Unsetenv("ORKEY_NEW_NAME")
+	}()
+
+	cfg := &Config{Prefix: "ORKEY_"}
+
+	// Primary value wins
+	if val := cfg.Key("NEW_NAME").OrKey("OLD_NAME").String(); val != "current" {
+		t.Errorf("OrKey() with primary set expected 'current', got '%s'", val)
+	}
+
+	// Fallback is read (with prefix) when primary is empty
+	r := cfg.Key("MISSING").OrKey("OLD_NAME")
+	if r.String() != "legacy" || r.key != "ORKEY_MISSING" {
+		t.Errorf("OrKey() expected ORKEY_MISSING='legacy', got %s='%s'", r.key, r.String())
+	}
+
+	// OrKey is tried before Default
+	if val := cfg.Key("MISSING").OrKey("OLD_NAME").Default("static").String(); val != "legacy" {
+		t.Errorf("OrKey().Default() expected 'legacy', got '%s'", val)
+	}
+	if val := cfg.Key("MISSING").OrKey("ALSO_MISSING").Default("static").String(); val != "static" {
+		t.Errorf("OrKey().Default() with missing fallback expected 'static', got '%s'", val)
+	}
+
+	// Chained fallbacks
+	if val := cfg.Key("MISSING").OrKey("ALSO_MISSING").OrKey("OLD_NAME").String(); val != "legacy" {
+		t.Errorf("Chained OrKey() expected 'legacy', got '%s'", val)
+	}
+
+	// Required after OrKey
+	if err := cfg.Key("MISSING").OrKey("ALSO_MISSING").Required().err; err == nil {
+		t.Error("Required() after missing OrKey() should set error")
+	}
+
+	// Prior error is preserved and fallback not applied
+	r = createTestResult("")
+	r.err = errors.New("initial error")
+	if r.OrKey("ORKEY_OLD_NAME").value != "" || r.err.Error() != "initial error" {
+		t.Errorf("OrKey() with error should not apply fallback, got '%s' (%v)", r.value, r.err)
+	}
+
+	// Works without a config
+	r = &result{key: "X"}
+	if val := r.OrKey("ORKEY_OLD_NAME").String(); val != "legacy" {
+		t.Errorf("OrKey() without config expected 'legacy', got '%s'", val)
+	}
+}
+
+// TestResultPercent tests parsing percentages into fractions
+func TestResultPercent(t *testing.T) {
+	cases := map[string]float64{
+		"25%":    0.25,
+		"100%":   1,
+		"0%":     0,
+		"12.5 %": 0.125,
+		"0.25":   0.25,
+		"25":     25,
+	}
+	for input, expected := range cases {
+		val, err := createTestResult(input).Percent()
+		if err != nil || val != expected {
+			t.Errorf("Percent() for '%s' expected %v, got %v (error: %v)", input, expected, val, err)
+		}
+	}
+
+	for _, input := range []string{"%", "abc%", "abc", "25%%"} {
+		if _, err := createTestResult(input).Percent(); err == nil {
+			t.Errorf("Percent() for '%s' should fail", input)
+		}
+	}
+
+	if _, err := createTestResult("").Percent(); err == nil {
+		t.Error("Percent() with empty value should fail")
+	}
+
+	r := createTestResult("25%")
+	r.err = errors.New("initial error")
+	if _, err := r.Percent(); err == nil || err.Error() != "initial error" {
+		t.Errorf("Percent() expected 'initial error', got %v", err)
+	}
+}
+
+// TestResultDefaultFunc tests lazily computed defaults in the chain
+func TestResultDefaultFunc(t *testing.T) {
+	calls := 0
+	fn := func() string {
+		calls++
+		return "lazy"
+	}
+
+	if val := createTestResult("set").DefaultFunc(fn).String(); val != "set" || calls != 0 {
+		t.Errorf("DefaultFunc() with value expected 'set' and no call, got '%s' (%d calls)", val, calls)
+	}
+	if val := createTestResult("").DefaultFunc(fn).String(); val != "lazy" || calls != 1 {
+		t.Errorf("DefaultFunc() with empty value expected 'lazy' and 1 call, got '%s' (%d calls)", val, calls)
+	}
+
+	r := createTestResult("")
+	r.err = errors.New("initial error")
+	if r.DefaultFunc(fn); r.value != "" || calls != 1 {
+		t.Errorf("DefaultFunc() with error should not call fn, got '%s' (%d calls)", r.value, calls)
+	}
+}
+
+// TestResultScanf tests extracting structured values with a format string
+func TestResultScanf(t *testing.T) {
+	var host string
+	var port int
+	if err := createTestResult("db.local 5432").Scanf("%s %d", &host, &port); err != nil {
+		t.Fatalf("Scanf() unexpected error: %v", err)
+	}
+	if host != "db.local" || port != 5432 {
+		t.Errorf("Scanf() expected (db.local, 5432), got (%s, %d)", host, port)
+	}
+
+	var width, height int
+	if err := createTestResult("1920x1080").Scanf("%dx%d", &width, &height); err != nil || width != 1920 || height != 1080 {
+		t.Errorf("Scanf(%%dx%%d) expected (1920, 1080), got (%d, %d) (error: %v)", width, height, err)
+	}
+
+	// Mismatching value
+	err := createTestResult("abc").Scanf("%d", &port)
+	if err == nil || !strings.Contains(err.Error(), "tidak sesuai format") {
+		t.Errorf("Scanf() with mismatching value expected format error, got %v", err)
+	}
+
+	// Empty value, Required and prior error
+	if err := createTestResult("").Scanf("%d", &port); err == nil {
+		t.Error("Scanf() with empty value should fail")
+	}
+	if err := createTestResult("").Required().Scanf("%d", &port); err == nil || !strings.Contains(err.Error(), "wajib diisi") {
+		t.Errorf("Required().Scanf() expected required error, got %v", err)
+	}
+	r := createTestResult("1")
+	r.err = errors.New("initial error")
+	if err := r.Scanf("%d", &port); err == nil || err.Error() != "initial error" {
+		t.Errorf("Scanf() expected 'initial error', got %v", err)
+	}
+}
+
+// TestResultISODuration tests reading ISO-8601 durations in the chain
+func TestResultISODuration(t *testing.T) {
+	if val, err := createTestResult("PT1H30M").ISODuration(); err != nil || val != 90*time.Minute {
+		t.Errorf("ISODuration() expected 1h30m, got %v (error: %v)", val, err)
+	}
+	if _, err := createTestResult("P1Y").ISODuration(); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Errorf("ISODuration() with years expected unsupported error, got %v", err)
+	}
+	if _, err := createTestResult("").ISODuration(); err == nil {
+		t.Error("ISODuration() with empty value should fail")
+	}
+	r := createTestResult("PT1S")
+	r.err = errors.New("initial error")
+	if _, err := r.ISODuration(); err == nil || err.Error() != "initial error" {
+		t.Errorf("ISODuration() expected 'initial error', got %v", err)
+	}
+}
+
+// TestResultErr tests inspecting the chain error state
+func TestResultErr(t *testing.T) {
+	r := createTestResult("value")
+	if err := r.Required().Err(); err != nil {
+		t.Errorf("Err() with valid value expected nil, got %v", err)
+	}
+
+	r = createTestResult("")
+	if err := r.Required().Err(); err == nil {
+		t.Error("Err() after failed Required() expected error")
+	}
+	// String still returns the raw value regardless of the error
+	if val := r.String(); val != "" {
+		t.Errorf("String() expected raw empty value, got '%s'", val)
+	}
+
+	r = createTestResult("http://x")
+	if err := r.HasPrefix("https://").Err(); err == nil || r.String() != "http://x" {
+		t.Errorf("Err() expected prefix error while String() keeps value, got %v / '%s'", err, r.String())
+	}
+}
+
+// TestResultRedacted tests masking values for safe logging
+func TestResultRedacted(t *testing.T) {
+	tests := []struct {
+		value    string
+		redacted string
+		full     string
+	}{
+		{"sk-live-123456", "s***6", RedactedValue},
+		{"abcdef", "a***f", RedactedValue},
+		{"abc", RedactedValue, RedactedValue},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		r := createTestResult(tt.value)
+		if got := r.Redacted(); got != tt.redacted {
+			t.Errorf("Redacted() for '%s' expected '%s', got '%s'", tt.value, tt.redacted, got)
+		}
+		if got := r.RedactedFull(); got != tt.full {
+			t.Errorf("RedactedFull() for '%s' expected '%s', got '%s'", tt.value, tt.full, got)
+		}
+	}
+
+	// Default values are masked as well
+	if got := createTestResult("").Default("secret-default").Redacted(); got != "s***t" {
+		t.Errorf("Redacted() after Default expected 's***t', got '%s'", got)
+	}
+}
+
+// TestResultPairs tests ordered key/value parsing in the fluent API
+func TestResultPairs(t *testing.T) {
+	r := createTestResult("b:2,a:1,b:3")
+	expected := []KeyValue{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}, {Key: "b", Value: "3"}}
+	if pairs := r.Pairs("", ""); !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("Pairs() expected %v, got %v", expected, pairs)
+	}
+
+	r.err = errors.New("initial error")
+	if pairs := r.Pairs(",", ":"); len(pairs) != 0 {
+		t.Errorf("Pairs() with error expected empty slice, got %v", pairs)
+	}
+}
+
+// TestResultSliceMin tests requiring a minimum number of non-empty elements
+func TestResultSliceMin(t *testing.T) {
+	r := createTestResult("a.com, ,b.com")
+	items, err := r.SliceMin(",", 2)
+	if err != nil || !reflect.DeepEqual(items, []string{"a.com", "b.com"}) {
+		t.Errorf("SliceMin() expected [a.com b.com], got %v (error: %v)", items, err)
+	}
+
+	if _, err := createTestResult("a.com,,").SliceMin(",", 2); err == nil {
+		t.Error("SliceMin() with one non-empty element should fail for min 2")
+	}
+	if _, err := createTestResult("").SliceMin(",", 1); err == nil {
+		t.Error("SliceMin() with empty value should fail for min 1")
+	}
+	if items, err := createTestResult("").SliceMin(",", 0); err != nil || len(items) != 0 {
+		t.Errorf("SliceMin() with min 0 expected empty result, got %v (error: %v)", items, err)
+	}
+
+	r = createTestResult("a")
+	r.err = errors.New("initial error")
+	if _, err := r.SliceMin(",", 1); err == nil || err.Error() != "initial error" {
+		t.Errorf("SliceMin() expected 'initial error', got %v", err)
+	}
+}
+
+// TestResultOrMethods tests lenient defaults for missing, empty, and invalid values
+func TestResultOrMethods(t *testing.T) {
+	for _, value := range []string{"", "abc"} {
+		r := createTestResult(value)
+		if got := r.IntOr(8080); got != 8080 {
+			t.Errorf("IntOr() for '%s' expected 8080, got %d", value, got)
+		}
+		if got := r.Float64Or(0.5); got != 0.5 {
+			t.Errorf("Float64Or() for '%s' expected 0.5, got %v", value, got)
+		}
+		if got := r.DurationOr(time.Second); got != time.Second {
+			t.Errorf("DurationOr() for '%s' expected 1s, got %v", value, got)
+		}
+		if got := r.BoolOr(true); got != true {
+			t.Errorf("BoolOr() for '%s' expected true, got %v", value, got)
+		}
+	}
+
+	if got := createTestResult("9090").IntOr(8080); got != 9090 {
+		t.Errorf("IntOr() expected 9090, got %d", got)
+	}
+	if got := createTestResult("1.5").Float64Or(0.5); got != 1.5 {
+		t.Errorf("Float64Or() expected 1.5, got %v", got)
+	}
+	if got := createTestResult("2m").DurationOr(time.Second); got != 2*time.Minute {
+		t.Errorf("DurationOr() expected 2m, got %v", got)
+	}
+	if got := createTestResult("no").BoolOr(true); got != false {
+		t.Errorf("BoolOr() for 'no' expected false, got %v", got)
+	}
+	if got := createTestResult("YES").BoolOr(false); got != true {
+		t.Errorf("BoolOr() for 'YES' expected true, got %v", got)
+	}
+
+	r := createTestResult("42")
+	r.err = errors.New("initial error")
+	if r.IntOr(1) != 1 || r.BoolOr(true) != true {
+		t.Error("Or methods with error should return the default")
+	}
+}
+
+// TestResultTime tests parsing timestamps with candidate layouts
+func TestResultTime(t *testing.T) {
+	tests := []struct {
+		value    string
+		layouts  []string
+		expected time.Time
+	}{
+		{"2024-03-01T10:30:00Z", nil, time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)},
+		{"2024-03-01 10:30:00", nil, time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)},
+		{"2024-03-01", nil, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"01/03/2024", []string{"2006-01-02", "02/01/2006"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got, err := createTestResult(tt.value).Time(tt.layouts...)
+		if err != nil || !got.Equal(tt.expected) {
+			t.Errorf("Time() for '%s' expected %v, got %v (error: %v)", tt.value, tt.expected, got, err)
+		}
+	}
+
+	_, err := createTestResult("yesterday").Time("2006-01-02", "02/01/2006")
+	if err == nil || !strings.Contains(err.Error(), "2006-01-02, 02/01/2006") {
+		t.Errorf("Time() with invalid value expected error listing layouts, got %v", err)
+	}
+	if _, err := createTestResult("").Time(); err == nil {
+		t.Error("Time() with empty value should fail")
+	}
+}
+
+// TestResultUintN tests unsigned parsing with explicit width and base
+func TestResultUintN(t *testing.T) {
+	tests := []struct {
+		value    string
+		bitSize  int
+		base     int
+		expected uint64
+		hasError bool
+	}{
+		{"1F90", 16, 16, 8080, false},
+		{"0x1F90", 16, 0, 8080, false},
+		{"65535", 16, 10, 65535, false},
+		{"65536", 16, 10, 0, true},
+		{"FFFF", 8, 16, 0, true},
+		{"-1", 64, 10, 0, true},
+		{"777", 32, 8, 511, false},
+		{"xyz", 32, 10, 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := createTestResult(tt.value).UintN(tt.bitSize, tt.base)
+		if (err != nil) != tt.hasError || got != tt.expected {
+			t.Errorf("UintN(%d, %d) for '%s' expected %d (error %v), got %d (error: %v)",
+				tt.bitSize, tt.base, tt.value, tt.expected, tt.hasError, got, err)
+		}
+	}
+
+	if _, err := createTestResult("65536").UintN(16, 10); err == nil || !strings.Contains(err.Error(), "di luar rentang uint16") {
+		t.Errorf("UintN() overflow expected range error, got %v", err)
+	}
+	if _, err := createTestResult("").UintN(16, 10); err == nil {
+		t.Error("UintN() with empty value should fail")
+	}
+}
+
+// TestResultSliceRequireAll tests rejecting lists with empty elements
+func TestResultSliceRequireAll(t *testing.T) {
+	items, err := createTestResult("a, b ,c").SliceRequireAll(",")
+	if err != nil || !reflect.DeepEqual(items, []string{"a", "b", "c"}) {
+		t.Errorf("SliceRequireAll() expected [a b c], got %v (error: %v)", items, err)
+	}
+
+	_, err = createTestResult("a,,c").SliceRequireAll(",")
+	if err == nil || !strings.Contains(err.Error(), "indeks 1") {
+		t.Errorf("SliceRequireAll() expected error at index 1, got %v", err)
+	}
+	_, err = createTestResult("a;b; ").SliceRequireAll(";")
+	if err == nil || !strings.Contains(err.Error(), "indeks 2") {
+		t.Errorf("SliceRequireAll() expected error at index 2, got %v", err)
+	}
+	if _, err := createTestResult("").SliceRequireAll(","); err == nil {
+		t.Error("SliceRequireAll() with empty value should fail")
+	}
+}
+
+// TestResultEquals tests expected-value assertions in the chain
+func TestResultEquals(t *testing.T) {
+	if _, err := createTestResult("3").Equals("3").Int(); err != nil {
+		t.Errorf("Equals() with matching value should pass, got %v", err)
+	}
+
+	_, err := createTestResult("2").Equals("3").Int()
+	if err == nil || !strings.Contains(err.Error(), `"3"`) || !strings.Contains(err.Error(), `"2"`) {
+		t.Errorf("Equals() mismatch expected error with expected and actual, got %v", err)
+	}
+	if err := createTestResult("").Equals("3").Err(); err == nil {
+		t.Error("Equals() with empty value should fail")
+	}
+
+	if err := createTestResult(" 3 ").EqualsInt(3).Err(); err != nil {
+		t.Errorf("EqualsInt() with matching value should pass, got %v", err)
+	}
+	if err := createTestResult("4").EqualsInt(3).Err(); err == nil {
+		t.Error("EqualsInt() with different value should fail")
+	}
+	if err := createTestResult("three").EqualsInt(3).Err(); err == nil {
+		t.Error("EqualsInt() with non-integer value should fail")
+	}
+
+	// Earlier errors are kept
+	r := createTestResult("").Required().Equals("")
+	if err := r.Err(); err == nil || !strings.Contains(err.Error(), "wajib diisi") {
+		t.Errorf("Equals() expected to keep Required error, got %v", err)
+	}
+}
+
+// TestResultMapInto tests merging env pairs into an existing map
+func TestResultMapInto(t *testing.T) {
+	defaults := map[string]string{"timeout": "30s", "retries": "3"}
+	if err := createTestResult("retries:5,region:eu").MapInto(defaults); err != nil {
+		t.Fatalf("MapInto() failed: %v", err)
+	}
+	expected := map[string]string{"timeout": "30s", "retries": "3", "region": "eu"}
+	if !reflect.DeepEqual(defaults, expected) {
+		t.Errorf("MapInto() without overwrite expected %v, got %v", expected, defaults)
+	}
+
+	if err := createTestResult("retries:5").MapInto(defaults, true); err != nil || defaults["retries"] != "5" {
+		t.Errorf("MapInto() with overwrite expected retries '5', got '%s' (error: %v)", defaults["retries"], err)
+	}
+
+	r := createTestResult("extra:1")
+	r.err = errors.New("initial error")
+	if err := r.MapInto(defaults); err == nil || defaults["extra"] != "" {
+		t.Errorf("MapInto() with chain error should be a no-op, got %v (map %v)", err, defaults)
+	}
+}
+
+// TestResultDefaultFile tests reading default values from a file
+func TestResultDefaultFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(path, []byte("\n{\"allow\": true}\n"), 0600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	if val := createTestResult("").DefaultFile(path).String(); val != `{"allow": true}` {
+		t.Errorf("DefaultFile() expected trimmed file content, got '%s'", val)
+	}
+	if val := createTestResult("inline").DefaultFile(path).String(); val != "inline" {
+		t.Errorf("DefaultFile() with value set expected 'inline', got '%s'", val)
+	}
+
+	r := createTestResult("").DefaultFile(filepath.Join(t.TempDir(), "missing.json"))
+	if err := r.Err(); err == nil || !strings.Contains(err.Error(), "missing.json") {
+		t.Errorf("DefaultFile() with missing file expected error, got %v", err)
+	}
+
+	r = createTestResult("")
+	r.err = errors.New("initial error")
+	if err := r.DefaultFile(path).Err(); err.Error() != "initial error" || r.value != "" {
+		t.Errorf("DefaultFile() should be skipped after an error, got %v", err)
+	}
+}
+
+// TestResultMaxLen tests rune-based string truncation and length validation
+func TestResultMaxLen(t *testing.T) {
+	if got := createTestResult("héllo wörld").MaxLen(5).String(); got != "héllo" {
+		t.Errorf("MaxLen(5) expected 'héllo', got '%s'", got)
+	}
+
+	if got := createTestResult("日本語テキスト").MaxLen(3).String(); got != "日本語" {
+		t.Errorf("MaxLen(3) expected '日本語', got '%s'", got)
+	}
+
+	if got := createTestResult("abc").MaxLen(10).String(); got != "abc" {
+		t.Errorf("MaxLen on short value expected 'abc', got '%s'", got)
+	}
+
+	if err := createTestResult("abc").MaxLen(-1).Err(); err == nil {
+		t.Error("MaxLen(-1) expected error, got nil")
+	}
+
+	if err := createTestResult("日本語").RequireMaxLen(3).Err(); err != nil {
+		t.Errorf("RequireMaxLen(3) on 3 runes expected no error, got %v", err)
+	}
+
+	if err := createTestResult("日本語テ").RequireMaxLen(3).Err(); err == nil {
+		t.Error("RequireMaxLen(3) on 4 runes expected error")
+	}
+}
+
+// TestResultOrError tests that OrError attaches the caller's error only to empty values
+func TestResultOrError(t *testing.T) {
+	errNoLicense := errors.New("no license")
+
+	if err := createTestResult("").OrError(errNoLicense).Err(); !errors.Is(err, errNoLicense) {
+		t.Errorf("OrError on empty value expected %v, got %v", errNoLicense, err)
+	}
+
+	if err := createTestResult("abc").OrError(errNoLicense).Err(); err != nil {
+		t.Errorf("OrError on non-empty value returned error: %v", err)
+	}
+
+	r := createTestResult("")
+	r.err = fmt.Errorf("earlier")
+	if err := r.OrError(errNoLicense).Err(); errors.Is(err, errNoLicense) {
+		t.Error("OrError should keep an existing error")
+	}
+}
+
+// TestResultSliceIndexedMap tests converting a list into an index keyed map
+func TestResultSliceIndexedMap(t *testing.T) {
+	expected := map[int]string{0: "a", 1: "b", 2: "c"}
+	if got := createTestResult(" a, b ,c").SliceIndexedMap(","); !reflect.DeepEqual(got, expected) {
+		t.Errorf("SliceIndexedMap expected %v, got %v", expected, got)
+	}
+
+	expected = map[int]string{0: "a", 2: "c"}
+	if got := createTestResult("a,,c").SliceIndexedMap(","); !reflect.DeepEqual(got, expected) {
+		t.Errorf("SliceIndexedMap with empty element expected %v, got %v", expected, got)
+	}
+
+	if got := createTestResult("").SliceIndexedMap(","); got == nil || len(got) != 0 {
+		t.Errorf("SliceIndexedMap on empty value expected empty map, got %v", got)
+	}
+}
+
+// TestResultDurationInRange tests DurationInRange erroring instead of clamping
+func TestResultDurationInRange(t *testing.T) {
+	if d, err := createTestResult("10s").DurationInRange(time.Second, 30*time.Second); err != nil || d != 10*time.Second {
+		t.Errorf("DurationInRange(10s) expected 10s, got %v (error: %v)", d, err)
+	}
+	if d, err := createTestResult("30s").DurationInRange(time.Second, 30*time.Second); err != nil || d != 30*time.Second {
+		t.Errorf("DurationInRange on max bound expected 30s, got %v (error: %v)", d, err)
+	}
+	if _, err := createTestResult("45s").DurationInRange(time.Second, 30*time.Second); err == nil || !strings.Contains(err.Error(), "TEST_KEY") {
+		t.Errorf("DurationInRange(45s) expected range error, got %v", err)
+	}
+	if _, err := createTestResult("500ms").DurationInRange(time.Second, 30*time.Second); err == nil {
+		t.Error("DurationInRange(500ms) expected range error")
+	}
+	if _, err := createTestResult("abc").DurationInRange(time.Second, 30*time.Second); err == nil {
+		t.Error("DurationInRange with invalid value expected error")
+	}
+}
+
+// TestResultStringOr tests StringOr falling back on empty values and chain errors
+func TestResultStringOr(t *testing.T) {
+	if got := createTestResult("value").StringOr("fallback"); got != "value" {
+		t.Errorf("StringOr expected 'value', got '%s'", got)
+	}
+	if got := createTestResult("").StringOr("fallback"); got != "fallback" {
+		t.Errorf("StringOr on empty value expected 'fallback', got '%s'", got)
+	}
+	if got := createTestResult("changeme").RequiredNot("changeme").StringOr("fallback"); got != "fallback" {
+		t.Errorf("StringOr after chain error expected 'fallback', got '%s'", got)
+	}
+}
+
+// TestResultURLParams tests reading query parameters from URL values
+func TestResultURLParams(t *testing.T) {
+	r := createTestResult("redis://host:6379?db=2&pool=10&timeout=5s")
+	params, err := r.URLParams()
+	if err != nil {
+		t.Fatalf("URLParams failed: %v", err)
+	}
+	if params.Get("db") != "2" || params.Get("pool") != "10" {
+		t.Errorf("URLParams expected db=2 and pool=10, got %v", params)
+	}
+
+	if db, err := createTestResult("redis://host:6379?db=2").URLParam("db").Int(); err != nil || db != 2 {
+		t.Errorf("URLParam(db).Int() expected 2, got %d (error: %v)", db, err)
+	}
+	if timeout := createTestResult("redis://host?timeout=5s").URLParam("timeout").DurationDefault(time.Second); timeout != 5*time.Second {
+		t.Errorf("URLParam(timeout) expected 5s, got %v", timeout)
+	}
+	if pool := createTestResult("redis://host").URLParam("pool").IntDefault(8); pool != 8 {
+		t.Errorf("URLParam for missing param expected default 8, got %d", pool)
+	}
+
+	if _, err := createTestResult("").URLParams(); err == nil {
+		t.Error("URLParams on empty value expected error")
+	}
+	if _, err := createTestResult("redis://host:port:bad?db=2").URLParams(); err == nil {
+		t.Error("URLParams on malformed URL expected error")
+	}
+	if _, err := createTestResult("redis://host?db=%zz").URLParams(); err == nil {
+		t.Error("URLParams on malformed query expected error")
+	}
+	if _, err := createTestResult("").URLParam("db").Int(); err == nil {
+		t.Error("URLParam on empty value expected error")
+	}
+}
+
+// TestResultSliceCase tests lowercasing and uppercasing slice elements
+func TestResultSliceCase(t *testing.T) {
+	expected := []string{"api.example.com", "localhost"}
+	if got := createTestResult(" API.Example.com ,Localhost").SliceLower(","); !reflect.DeepEqual(got, expected) {
+		t.Errorf("SliceLower expected %v, got %v", expected, got)
+	}
+	expected = []string{"EU-WEST-1", "US-EAST-2"}
+	if got := createTestResult("eu-west-1, us-East-2").SliceUpper(","); !reflect.DeepEqual(got, expected) {
+		t.Errorf("SliceUpper expected %v, got %v", expected, got)
+	}
+
+	r := createTestResult("A,B")
+	r.err = fmt.Errorf("earlier")
+	if got := r.SliceLower(","); len(got) != 0 {
+		t.Errorf("SliceLower after chain error expected empty slice, got %v", got)
+	}
+}
+
+// TestResultBytes tests byte size parsing with decimal and binary units
+func TestResultBytes(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected int64
+	}{
+		{"512", 512},
+		{"1KB", 1000},
+		{"1KiB", 1024},
+		{"512MB", 512 * 1000 * 1000},
+		{"512MiB", 512 << 20},
+		{"1GiB", 1 << 30},
+		{" 1.5 GB ", 1500 * 1000 * 1000},
+	}
+
+	for _, tc := range tests {
+		if got, err := createTestResult(tc.value).Bytes(); err != nil || got != tc.expected {
+			t.Errorf("Bytes(%q) expected %d, got %d (error: %v)", tc.value, tc.expected, got, err)
+		}
+	}
+
+	if _, err := createTestResult("10XB").Bytes(); err == nil {
+		t.Error("Bytes with unknown unit expected error")
+	}
+	if _, err := createTestResult("").Bytes(); err == nil {
+		t.Error("Bytes on empty value expected error")
+	}
+	if got, err := createTestResult("10000000TB").Bytes(); err == nil {
+		t.Errorf("Bytes on overflowing size expected error, got %d", got)
+	}
+	if got := createTestResult("bad").BytesDefault(64 << 20); got != 64<<20 {
+		t.Errorf("BytesDefault on invalid value expected %d, got %d", int64(64<<20), got)
+	}
+}
+
+// TestResultIntSI tests decimal SI suffixes for large counts
+func TestResultIntSI(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected int64
+	}{
+		{"42", 42},
+		{"-7", -7},
+		{"10k", 10000},
+		{"10K", 10000},
+		{"2.5M", 2500000},
+		{"1G", 1000000000},
+		{" 3 k ", 3000},
+	}
+
+	for _, tc := range tests {
+		if got, err := createTestResult(tc.value).IntSI(); err != nil || got != tc.expected {
+			t.Errorf("IntSI(%q) expected %d, got %d (error: %v)", tc.value, tc.expected, got, err)
+		}
+	}
+
+	for _, value := range []string{"10KiB", "5T", "1.5", "1.0001k", "abc", "k", "9223372036854775808", "9223372036.854775808G"} {
+		if _, err := createTestResult(value).IntSI(); err == nil {
+			t.Errorf("IntSI(%q) expected error", value)
+		}
+	}
+}
+
+// TestResultDurationSum tests summing delimited durations
+func TestResultDurationSum(t *testing.T) {
+	if got, err := createTestResult("1h, 30m,15s").DurationSum(","); err != nil || got != time.Hour+30*time.Minute+15*time.Second {
+		t.Errorf("DurationSum expected 1h30m15s, got %v (error: %v)", got, err)
+	}
+	if got, err := createTestResult("1h,-15m").DurationSum(","); err != nil || got != 45*time.Minute {
+		t.Errorf("DurationSum with negative element expected 45m, got %v (error: %v)", got, err)
+	}
+	if _, err := createTestResult("1h,abc").DurationSum(","); err == nil || !strings.Contains(err.Error(), "indeks 1") {
+		t.Errorf("DurationSum with bad element expected error at index 1, got %v", err)
+	}
+	if _, err := createTestResult("1h,,15s").DurationSum(","); err == nil {
+		t.Error("DurationSum with empty element expected error")
+	}
+	if _, err := createTestResult("2562047h,2562047h").DurationSum(","); err == nil {
+		t.Error("DurationSum beyond time.Duration range expected error")
+	}
+	if _, err := createTestResult("").DurationSum(","); err == nil {
+		t.Error("DurationSum on empty value expected error")
+	}
+}
+
+// TestResultSliceMap tests parsing list-valued maps with three delimiters
+func TestResultSliceMap(t *testing.T) {
+	got := createTestResult("web: a.com | b.com , api:c.com,empty:,bad,web:d.com").SliceMap(",", ":", "|")
+	expected := map[string][]string{
+		"web":   {"a.com", "b.com", "d.com"},
+		"api":   {"c.com"},
+		"empty": {},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("SliceMap expected %v, got %v", expected, got)
+	}
+
+	expected = map[string][]string{"eu": {"a", "b"}, "us": {"c"}}
+	got = createTestResult("eu=a;b/us=c").SliceMap("/", "=", ";")
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("SliceMap with custom delimiters expected %v, got %v", expected, got)
+	}
+
+	if got := createTestResult("").SliceMap("", "", ""); got == nil || len(got) != 0 {
+		t.Errorf("SliceMap on empty value expected empty map, got %v", got)
+	}
+}
+
+// TestResultJSONSlice tests decoding JSON arrays into slices
+func TestResultJSONSlice(t *testing.T) {
+	var ids []int
+	expectedIDs := []int{1, 2, 3}
+	if err := createTestResult("[1, 2, 3]").JSONSlice(&ids); err != nil || !reflect.DeepEqual(ids, expectedIDs) {
+		t.Errorf("JSONSlice into []int expected %v, got %v (error: %v)", expectedIDs, ids, err)
+	}
+
+	var names []string
+	expectedNames := []string{"a,b", "c"}
+	if err := createTestResult(`["a,b", "c"]`).JSONSlice(&names); err != nil || !reflect.DeepEqual(names, expectedNames) {
+		t.Errorf("JSONSlice into []string expected %v, got %v (error: %v)", expectedNames, names, err)
+	}
+
+	if err := createTestResult("[1, 2").JSONSlice(&ids); err == nil {
+		t.Error("JSONSlice with malformed JSON expected error")
+	}
+	if err := createTestResult("[1]").JSONSlice(ids); err == nil {
+		t.Error("JSONSlice with non-pointer expected error")
+	}
+	var m map[string]int
+	if err := createTestResult("{}").JSONSlice(&m); err == nil {
+		t.Error("JSONSlice with pointer to map expected error")
+	}
+}
+
+// TestResultIntSigned tests explicit sign handling in Int and IntSigned
+func TestResultIntSigned(t *testing.T) {
+	if v, err := createTestResult("+42").Int(); err != nil || v != 42 {
+		t.Errorf("Int(+42) expected 42, got %d (error: %v)", v, err)
+	}
+
+	if v, err := createTestResult("+5").IntSigned(); err != nil || v != 5 {
+		t.Errorf("IntSigned(+5) expected 5, got %d (error: %v)", v, err)
+	}
+	if v, err := createTestResult(" -5 ").IntSigned(); err != nil || v != -5 {
+		t.Errorf("IntSigned(-5) expected -5, got %d (error: %v)", v, err)
+	}
+	if _, err := createTestResult("5").IntSigned(); err == nil {
+		t.Error("IntSigned(5) without sign expected error")
+	}
+	if _, err := createTestResult("+abc").IntSigned(); err == nil {
+		t.Error("IntSigned(+abc) expected error")
+	}
+	if _, err := createTestResult("").IntSigned(); err == nil {
+		t.Error("IntSigned on empty value expected error")
+	}
+}
+
+// TestResultDefaultDev tests defaults that only apply outside production mode
+func TestResultDefaultDev(t *testing.T) {
+	dev := &result{config: &Config{Mode: Development}, key: "DB_PASS"}
+	if val := dev.DefaultDev("devpass").String(); val != "devpass" {
+		t.Errorf("DefaultDev() in development expected 'devpass', got '%s'", val)
+	}
+
+	prod := &result{config: &Config{Mode: Production}, key: "DB_PASS"}
+	if err := prod.DefaultDev("devpass").Required().Err(); err == nil {
+		t.Error("DefaultDev() in production should leave value empty so Required fails")
+	}
+
+	set := &result{config: &Config{Mode: Staging}, key: "DB_PASS", value: "secret"}
+	if val := set.DefaultDev("devpass").String(); val != "secret" {
+		t.Errorf("DefaultDev() with value expected 'secret', got '%s'", val)
+	}
+}
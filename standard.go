@@ -0,0 +1,59 @@
+package env
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LogLevels adalah daftar log level yang valid untuk StandardConfig
+var LogLevels = []string{"debug", "info", "warn", "error", "fatal"}
+
+// StandardConfig adalah struct siap pakai untuk pengaturan aplikasi yang umum dipakai.
+// Bersifat opsional, gunakan struct sendiri dengan Parse untuk kebutuhan lain
+type StandardConfig struct {
+	Host            string        `env:"HOST" default:"0.0.0.0"`
+	Port            int           `env:"PORT" default:"8080"`
+	LogLevel        string        `env:"LOG_LEVEL" default:"info"`
+	Debug           bool          `env:"DEBUG" default:"false"`
+	ReadTimeout     time.Duration `env:"READ_TIMEOUT" default:"15s"`
+	WriteTimeout    time.Duration `env:"WRITE_TIMEOUT" default:"15s"`
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" default:"30s"`
+}
+
+// Addr mengembalikan alamat host:port
+func (s *StandardConfig) Addr() string {
+	return fmt.Sprintf("%s:%d", s.Host, s.Port)
+}
+
+// validate memeriksa nilai StandardConfig dan menormalkan LogLevel ke huruf kecil
+func (s *StandardConfig) validate() error {
+	s.LogLevel = strings.ToLower(s.LogLevel)
+	for _, level := range LogLevels {
+		if s.LogLevel == level {
+			return nil
+		}
+	}
+	return fmt.Errorf("log level tidak valid: %s (pilihan: %s)", s.LogLevel, strings.Join(LogLevels, ", "))
+}
+
+// LoadStandard mengisi StandardConfig dari environment dan memvalidasi LogLevel
+func (c *Config) LoadStandard() (*StandardConfig, error) {
+	standard := &StandardConfig{}
+	if err := c.Parse(standard); err != nil {
+		return nil, err
+	}
+	if err := standard.validate(); err != nil {
+		return nil, err
+	}
+	return standard, nil
+}
+
+// LoadStandard adalah fungsi level package yang mengisi StandardConfig dari environment
+func LoadStandard() (*StandardConfig, error) {
+	cfg, err := getDefaultInstance()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.LoadStandard()
+}
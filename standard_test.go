@@ -0,0 +1,85 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestLoadStandard tests the opinionated StandardConfig
+func TestLoadStandard(t *testing.T) {
+	cfg := &Config{Prefix: "STD_"}
+
+	// Defaults
+	standard, err := cfg.LoadStandard()
+	if err != nil {
+		t.Fatalf("LoadStandard failed: %v", err)
+	}
+	expected := StandardConfig{
+		Host:            "0.0.0.0",
+		Port:            8080,
+		LogLevel:        "info",
+		ReadTimeout:     15 * time.Second,
+		WriteTimeout:    15 * time.Second,
+		ShutdownTimeout: 30 * time.Second,
+	}
+	if *standard != expected {
+		t.Errorf("LoadStandard defaults expected %+v, got %+v", expected, *standard)
+	}
+	if standard.Addr() != "0.0.0.0:8080" {
+		t.Errorf("Addr() expected '0.0.0.0:8080', got '%s'", standard.Addr())
+	}
+
+	// Values from environment, log level is normalized
+	os.Setenv("STD_PORT", "9000")
+	os.Setenv("STD_LOG_LEVEL", "DEBUG")
+	os.Setenv("STD_DEBUG", "true")
+	defer func() {
+		os.Unsetenv("STD_PORT")
+		os.Unsetenv("STD_LOG_LEVEL")
+		os.Unsetenv("STD_DEBUG")
+	}()
+
+	standard, err = cfg.LoadStandard()
+	if err != nil {
+		t.Fatalf("LoadStandard failed: %v", err)
+	}
+	if standard.Port != 9000 || standard.LogLevel != "debug" || !standard.Debug {
+		t.Errorf("LoadStandard expected Port=9000 LogLevel=debug Debug=true, got %+v", *standard)
+	}
+
+	// Invalid log level
+	os.Setenv("STD_LOG_LEVEL", "verbose")
+	if _, err := cfg.LoadStandard(); err == nil {
+		t.Error("LoadStandard with invalid log level should fail")
+	}
+
+	// Invalid port
+	os.Setenv("STD_LOG_LEVEL", "info")
+	os.Setenv("STD_PORT", "http")
+	if _, err := cfg.LoadStandard(); err == nil {
+		t.Error("LoadStandard with invalid port should fail")
+	}
+}
+
+// TestLoadStandardPackageLevel tests the package-level LoadStandard
+func TestLoadStandardPackageLevel(t *testing.T) {
+	origGetDefaultInstance := getDefaultInstance
+	defer func() { getDefaultInstance = origGetDefaultInstance }()
+
+	getDefaultInstance = func() (*Config, error) {
+		return &Config{Prefix: "STD_PKG_"}, nil
+	}
+	if standard, err := LoadStandard(); err != nil || standard.Port != 8080 {
+		t.Errorf("LoadStandard() expected Port=8080, got %+v (error: %v)", standard, err)
+	}
+
+	mockErr := fmt.Errorf("mock error")
+	getDefaultInstance = func() (*Config, error) {
+		return nil, mockErr
+	}
+	if _, err := LoadStandard(); err != mockErr {
+		t.Errorf("LoadStandard() expected mock error, got %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package env
+
+import (
+	"reflect"
+	"sync"
+)
+
+// ValidatorFunc memvalidasi field yang sudah diisi oleh Parse
+type ValidatorFunc func(reflect.Value, reflect.StructField) error
+
+// validators menyimpan validator yang didaftarkan dengan RegisterValidator per reflect.Kind
+var (
+	validators      = make(map[reflect.Kind][]ValidatorFunc)
+	validatorsMutex sync.RWMutex
+)
+
+// RegisterValidator mendaftarkan validator untuk semua field dengan kind tertentu, misalnya
+// semua string tidak boleh berisi karakter kontrol. Validator dijalankan oleh Parse setelah
+// field diisi dan setelah validasi tag (min, max, minItems), sebelum Finalize. Beberapa
+// validator untuk kind yang sama dijalankan sesuai urutan pendaftaran, dan error pertama
+// menghentikan Parse. Field yang kosong dan dilewati tidak divalidasi
+func RegisterValidator(kind reflect.Kind, fn ValidatorFunc) {
+	validatorsMutex.Lock()
+	defer validatorsMutex.Unlock()
+	validators[kind] = append(validators[kind], fn)
+}
+
+// runValidators menjalankan validator yang terdaftar untuk kind field
+func runValidators(field reflect.Value, fieldType reflect.StructField) error {
+	validatorsMutex.RLock()
+	fns := validators[field.Kind()]
+	validatorsMutex.RUnlock()
+
+	for _, fn := range fns {
+		if err := fn(field, fieldType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
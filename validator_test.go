@@ -0,0 +1,67 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// TestRegisterValidator tests kind-wide validators in Parse
+func TestRegisterValidator(t *testing.T) {
+	var calls []string
+	RegisterValidator(reflect.String, func(v reflect.Value, f reflect.StructField) error {
+		calls = append(calls, f.Name)
+		for _, r := range v.String() {
+			if unicode.IsControl(r) {
+				return fmt.Errorf("control character in %s", f.Name)
+			}
+		}
+		return nil
+	})
+	RegisterValidator(reflect.Int, func(v reflect.Value, f reflect.StructField) error {
+		if v.Int() < 0 {
+			return fmt.Errorf("negative value")
+		}
+		return nil
+	})
+	defer func() {
+		validatorsMutex.Lock()
+		delete(validators, reflect.String)
+		delete(validators, reflect.Int)
+		validatorsMutex.Unlock()
+	}()
+
+	os.Setenv("VALIDATOR_NAME", "app")
+	os.Setenv("VALIDATOR_COUNT", "3")
+	defer os.Unsetenv("VALIDATOR_NAME")
+	defer os.Unsetenv("VALIDATOR_COUNT")
+
+	type validatedConfig struct {
+		Name  string `env:"VALIDATOR_NAME"`
+		Empty string `env:"VALIDATOR_EMPTY"`
+		Count int    `env:"VALIDATOR_COUNT"`
+	}
+
+	var cfg validatedConfig
+	if err := (&Config{}).Parse(&cfg); err != nil {
+		t.Fatalf("Parse with valid values failed: %v", err)
+	}
+	// Skipped empty fields are not validated
+	if !reflect.DeepEqual(calls, []string{"Name"}) {
+		t.Errorf("Validator expected to run for [Name], ran for %v", calls)
+	}
+
+	os.Setenv("VALIDATOR_NAME", "app\x07")
+	if err := (&Config{}).Parse(&validatedConfig{}); err == nil || !strings.Contains(err.Error(), "VALIDATOR_NAME") {
+		t.Errorf("Parse expected control character error, got %v", err)
+	}
+
+	os.Setenv("VALIDATOR_NAME", "app")
+	os.Setenv("VALIDATOR_COUNT", "-1")
+	if err := (&Config{}).Parse(&validatedConfig{}); err == nil || !strings.Contains(err.Error(), "negative value") {
+		t.Errorf("Parse expected negative value error, got %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package env
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// DefaultWatchInterval adalah interval polling default untuk Watch
+const DefaultWatchInterval = time.Second
+
+// Watch memantau file .env milik mode saat ini, atau dengan WithProfiles file .env dan
+// setiap .env.<profile>, dan memuat ulang nilainya setiap kali salah satu file berubah,
+// lalu memanggil onChange. Watch bersifat blocking
+// dan berhenti ketika ctx dibatalkan (mengembalikan ctx.Err()) atau Close dipanggil
+// (mengembalikan nil), sehingga biasanya dijalankan dalam goroutine.
+//
+// Perubahan dideteksi dengan polling waktu modifikasi dan ukuran file setiap
+// interval (lihat WithWatchInterval). Beberapa catatan:
+//   - Resolusi mtime bergantung pada filesystem (misalnya 2 detik pada FAT),
+//     sehingga perubahan beruntun yang sangat cepat bisa terbaca sebagai satu perubahan.
+//   - Saat reload, nilai dari file menimpa environment variable yang sudah ada.
+//   - Key yang dihapus dari file tidak dihapus dari environment proses.
+//   - Jika WithFreeze aktif, snapshot ikut diperbarui setelah reload.
+//   - Jika file sementara tidak dapat dibaca (misalnya saat editor menyimpan),
+//     reload dicoba lagi pada interval berikutnya dan pemantauan tetap berjalan.
+//   - Dengan WithDebounce, perubahan beruntun dalam jendela debounce digabung menjadi
+//     satu reload dan satu panggilan onChange dengan isi file terakhir.
+func (c *Config) Watch(ctx context.Context, onChange func()) error {
+	files, err := c.watchFiles()
+	if err != nil {
+		return err
+	}
+
+	interval := c.watchInterval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	lastStats := make([]os.FileInfo, len(files))
+	for i, file := range files {
+		lastStats[i], _ = os.Stat(file)
+	}
+	done := c.doneChan()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// pending menandai perubahan yang belum dimuat, changedAt adalah waktu perubahan terakhir
+	var pending bool
+	var changedAt time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-done:
+			return nil
+		case <-ticker.C:
+		}
+
+		for i, file := range files {
+			if stat, err := os.Stat(file); err == nil && fileChanged(lastStats[i], stat) {
+				lastStats[i] = stat
+				changedAt = time.Now()
+				pending = true
+			}
+		}
+
+		// Dengan WithDebounce, reload ditunda sampai file tidak berubah selama jendela debounce
+		if !pending || time.Since(changedAt) < c.debounce {
+			continue
+		}
+
+		if err := c.overloadWatched(files); err != nil {
+			continue
+		}
+		if c.snapshot != nil {
+			c.snapshot.refresh()
+		}
+		c.resetMust()
+		pending = false
+
+		if onChange != nil {
+			onChange()
+		}
+	}
+}
+
+// watchFiles mengembalikan file yang dipantau Watch: dengan WithProfiles, .env dan setiap
+// .env.<profile> sesuai urutan, selain itu file .env milik mode saat ini
+func (c *Config) watchFiles() ([]string, error) {
+	if len(c.profiles) > 0 {
+		return c.profilePaths(), nil
+	}
+	envFile, err := c.envFile()
+	if err != nil {
+		return nil, err
+	}
+	return []string{envFile}, nil
+}
+
+// overloadWatched memuat ulang files dan menimpa environment variable yang sudah ada.
+// Dengan WithProfiles, file yang tidak ada dilewati dan nilainya digabung secara berlapis
+func (c *Config) overloadWatched(files []string) error {
+	if len(c.profiles) == 0 {
+		return godotenv.Overload(files...)
+	}
+
+	var existing []string
+	for _, file := range files {
+		if fileExists(file) {
+			existing = append(existing, file)
+		}
+	}
+	values, err := readLayered(existing)
+	if err != nil {
+		return err
+	}
+	for key, value := range values {
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// watchState menyimpan channel yang ditutup oleh Close
+type watchState struct {
+	done   chan struct{}
+	closed bool
+}
+
+// watchMu melindungi alokasi dan isi watchState pada semua Config
+var watchMu sync.Mutex
+
+// Close menghentikan semua Watch yang berjalan pada config ini. Config tanpa Watch tidak
+// memiliki resource sehingga Close tidak melakukan apa-apa, dan Close aman dipanggil
+// berulang kali. Accessor seperti Get dan Parse tetap berfungsi setelah Close, hanya saja
+// nilainya tidak lagi dimuat ulang otomatis. Watch yang dipanggil setelah Close langsung
+// berhenti. Config hasil From atau Merge tidak ikut ditutup
+func (c *Config) Close() error {
+	watchMu.Lock()
+	defer watchMu.Unlock()
+
+	state := c.watchStateLocked()
+	if state.closed {
+		return nil
+	}
+	state.closed = true
+	close(state.done)
+	return nil
+}
+
+// doneChan mengembalikan channel yang ditutup oleh Close
+func (c *Config) doneChan() chan struct{} {
+	watchMu.Lock()
+	defer watchMu.Unlock()
+
+	return c.watchStateLocked().done
+}
+
+// watchStateLocked mengembalikan watchState milik config, mengalokasikannya jika belum ada.
+// Pemanggil harus memegang watchMu
+func (c *Config) watchStateLocked() *watchState {
+	if c.watch == nil {
+		c.watch = &watchState{done: make(chan struct{})}
+	}
+	return c.watch
+}
+
+// fileChanged memeriksa apakah file berubah berdasarkan waktu modifikasi dan ukurannya
+func fileChanged(prev, curr os.FileInfo) bool {
+	if prev == nil {
+		return true
+	}
+	return !prev.ModTime().Equal(curr.ModTime()) || prev.Size() != curr.Size()
+}
@@ -0,0 +1,257 @@
+package env
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestWatchReloadsOnChange tests that Watch reloads the file and calls onChange
+func TestWatchReloadsOnChange(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current directory: %v", err)
+	}
+	defer func() {
+		if err := os.Chdir(oldDir); err != nil {
+			t.Errorf("Failed to restore original directory: %v", err)
+		}
+	}()
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change to temp directory: %v", err)
+	}
+
+	if err := os.WriteFile(".env.development", []byte("WATCH_VALUE=first"), 0644); err != nil {
+		t.Fatalf("Failed to create .env.development file: %v", err)
+	}
+	defer os.Unsetenv("WATCH_VALUE")
+
+	cfg, err := New(WithMode(Development), WithWatchInterval(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changed := make(chan struct{}, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- cfg.Watch(ctx, func() {
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		})
+	}()
+
+	// Ensure the new file differs in size and mtime
+	time.Sleep(20 * time.Millisecond)
+	future := time.Now().Add(time.Minute)
+	if err := os.WriteFile(".env.development", []byte("WATCH_VALUE=second_value"), 0644); err != nil {
+		t.Fatalf("Failed to update .env.development file: %v", err)
+	}
+	if err := os.Chtimes(".env.development", future, future); err != nil {
+		t.Fatalf("Failed to update file times: %v", err)
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Watch did not detect the file change")
+	}
+
+	if val := cfg.Get("WATCH_VALUE"); val != "second_value" {
+		t.Errorf("After reload expected 'second_value', got '%s'", val)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Watch expected context.Canceled, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Watch did not stop after cancel")
+	}
+}
+
+// TestWatchInvalidMode tests Watch with an invalid mode
+func TestWatchInvalidMode(t *testing.T) {
+	cfg := &Config{Mode: "invalid_mode"}
+	if err := cfg.Watch(context.Background(), nil); err == nil {
+		t.Error("Watch with invalid mode should return error")
+	}
+}
+
+// TestWithWatchInterval tests the watch interval option and its propagation
+func TestWithWatchInterval(t *testing.T) {
+	cfg := &Config{}
+	WithWatchInterval(5 * time.Second)(cfg)
+	if cfg.watchInterval != 5*time.Second {
+		t.Errorf("Expected watchInterval=5s, got %v", cfg.watchInterval)
+	}
+
+	if derived := cfg.From(WithPrefix("APP_")); derived.watchInterval != 5*time.Second {
+		t.Errorf("From() expected watchInterval=5s, got %v", derived.watchInterval)
+	}
+}
+
+// TestCloseStopsWatch tests that Close stops running watchers and is idempotent
+func TestCloseStopsWatch(t *testing.T) {
+	cfg := &Config{Mode: Development, watchInterval: 10 * time.Millisecond}
+	if cfg.watch != nil {
+		t.Fatal("Watch state expected to be allocated lazily, got non-nil before Watch")
+	}
+	copied := *cfg
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cfg.Watch(context.Background(), nil)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	if err := cfg.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Watch after Close expected nil, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Watch did not stop after Close")
+	}
+
+	if err := cfg.Close(); err != nil {
+		t.Errorf("Second Close failed: %v", err)
+	}
+	if copied.watch != nil {
+		t.Error("Config copied before Watch expected to keep its own watch state, got shared state")
+	}
+	if err := cfg.Watch(context.Background(), nil); err != nil {
+		t.Errorf("Watch on closed config expected nil, got %v", err)
+	}
+
+	os.Setenv("CLOSE_VALUE", "still works")
+	defer os.Unsetenv("CLOSE_VALUE")
+	if val := cfg.Get("CLOSE_VALUE"); val != "still works" {
+		t.Errorf("Get after Close expected 'still works', got '%s'", val)
+	}
+
+	if err := (&Config{}).Close(); err != nil {
+		t.Errorf("Close without watchers failed: %v", err)
+	}
+}
+
+// TestWatchDebounce tests that rapid writes are coalesced into a single reload
+func TestWatchDebounce(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current directory: %v", err)
+	}
+	defer os.Chdir(oldDir)
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change to temp directory: %v", err)
+	}
+
+	if err := os.WriteFile(".env.development", []byte("DEBOUNCE_VALUE=0"), 0644); err != nil {
+		t.Fatalf("Failed to create .env.development file: %v", err)
+	}
+	defer os.Unsetenv("DEBOUNCE_VALUE")
+
+	cfg, err := New(WithMode(Development), WithWatchInterval(5*time.Millisecond), WithDebounce(150*time.Millisecond))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := make(chan struct{}, 10)
+	go cfg.Watch(ctx, func() {
+		changes <- struct{}{}
+	})
+
+	// Simulate an editor writing the file several times in quick succession
+	base := time.Now().Add(time.Minute)
+	for i := 1; i <= 5; i++ {
+		content := "DEBOUNCE_VALUE=" + strings.Repeat("x", i)
+		if err := os.WriteFile(".env.development", []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to update .env.development file: %v", err)
+		}
+		stamp := base.Add(time.Duration(i) * time.Second)
+		if err := os.Chtimes(".env.development", stamp, stamp); err != nil {
+			t.Fatalf("Failed to update file times: %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	select {
+	case <-changes:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Watch did not reload after the burst")
+	}
+
+	// Give the watcher time to fire again if it were not debounced
+	time.Sleep(300 * time.Millisecond)
+	if extra := len(changes); extra != 0 {
+		t.Errorf("Expected a single onChange after the burst, got %d extra", extra)
+	}
+	if val := cfg.Get("DEBOUNCE_VALUE"); val != "xxxxx" {
+		t.Errorf("After burst expected the last contents 'xxxxx', got '%s'", val)
+	}
+}
+
+// TestWatchProfiles tests that Watch polls every profile file and reloads them in layers
+func TestWatchProfiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldDir, _ := os.Getwd()
+	os.Chdir(tmpDir)
+	defer os.Chdir(oldDir)
+
+	os.WriteFile(".env", []byte("WATCH_BASE=base\nWATCH_LAYER=base\n"), 0644)
+	os.WriteFile(".env.aws", []byte("WATCH_LAYER=aws\n"), 0644)
+	defer os.Unsetenv("WATCH_BASE")
+	defer os.Unsetenv("WATCH_LAYER")
+
+	cfg, err := New(WithProfiles("aws"), WithWatchInterval(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changed := make(chan struct{}, 1)
+	go cfg.Watch(ctx, func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	})
+
+	time.Sleep(20 * time.Millisecond)
+	future := time.Now().Add(time.Minute)
+	os.WriteFile(".env.aws", []byte("WATCH_LAYER=aws-updated\n"), 0644)
+	os.Chtimes(".env.aws", future, future)
+
+	select {
+	case <-changed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Watch did not detect the profile file change")
+	}
+
+	expected := map[string]string{
+		"WATCH_BASE":  "base",
+		"WATCH_LAYER": "aws-updated",
+	}
+	for k, v := range expected {
+		if got := cfg.Get(k); got != v {
+			t.Errorf("%s expected '%s', got '%s'", k, v, got)
+		}
+	}
+}